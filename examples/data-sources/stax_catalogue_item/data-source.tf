@@ -0,0 +1,8 @@
+variable "catalogue_item_name" {
+  description = "the name of the workload catalogue item to read"
+}
+
+# reads the most recently created active version of the catalogue item
+data "stax_catalogue_item" "latest" {
+  name = var.catalogue_item_name
+}
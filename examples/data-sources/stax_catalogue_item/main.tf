@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    stax = {
+      source = "registry.terraform.io/stax-labs/stax"
+    }
+  }
+}
+
+provider "stax" {
+}
+
+output "latest_catalogue_item" {
+  value = data.stax_catalogue_item.latest
+}
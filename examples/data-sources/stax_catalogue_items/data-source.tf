@@ -0,0 +1,5 @@
+data "stax_catalogue_items" "active" {
+  filters = {
+    statuses = ["ACTIVE"]
+  }
+}
@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    stax = {
+      source = "registry.terraform.io/stax-labs/stax"
+    }
+  }
+}
+
+provider "stax" {
+}
+
+output "active_catalogue_items" {
+  value = data.stax_catalogue_items.active
+}
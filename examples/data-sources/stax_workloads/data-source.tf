@@ -0,0 +1,11 @@
+variable "account_id" {
+  description = "the stax account identifier used to filter workloads"
+}
+
+data "stax_workloads" "shared_services" {
+  filters = {
+    account_ids = [var.account_id]
+    region      = "ap-southeast-2"
+    statuses    = ["ACTIVE", "UPDATE_COMPLETE"]
+  }
+}
@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    stax = {
+      source = "registry.terraform.io/stax-labs/stax"
+    }
+  }
+}
+
+provider "stax" {
+}
+
+output "shared_services_outputs" {
+  value = { for workload in data.stax_workloads.shared_services.workloads : workload.name => workload.outputs }
+}
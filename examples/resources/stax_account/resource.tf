@@ -8,4 +8,8 @@ resource "stax_account" "presentation-dev" {
   tags = {
     "environment" : "production"
   }
+
+  timeouts {
+    create = "2h"
+  }
 }
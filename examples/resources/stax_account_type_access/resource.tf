@@ -0,0 +1,17 @@
+variable "account_type_id" {
+  description = "the account type identifier to grant access to"
+}
+
+variable "group_id" {
+  description = "the identifier of the stax group granted access to accounts of this account type"
+}
+
+resource "stax_account_type_access" "production" {
+  account_type_id = var.account_type_id
+  role_mappings = [
+    {
+      group_id  = var.group_id
+      role_name = "readonly"
+    }
+  ]
+}
@@ -0,0 +1,18 @@
+variable "account_type_id" {
+  description = "the account type identifier the accounts belong to"
+}
+
+variable "fallback_account_type_id" {
+  description = "the account type identifier accounts are moved to when they are removed"
+}
+
+variable "account_ids" {
+  description = "the identifiers of the stax accounts which belong to the account type"
+  type        = list(string)
+}
+
+resource "stax_account_type_members" "production" {
+  account_type_id          = var.account_type_id
+  fallback_account_type_id = var.fallback_account_type_id
+  account_ids              = var.account_ids
+}
@@ -0,0 +1,14 @@
+variable "account_type_id" {
+  description = "the account type identifier to attach the policies to"
+}
+
+variable "policy_id" {
+  description = "the identifier of the stax policy attached to the account type"
+}
+
+resource "stax_account_type_policy_attachment" "production" {
+  account_type_id = var.account_type_id
+  policy_ids = [
+    var.policy_id
+  ]
+}
@@ -0,0 +1,10 @@
+terraform {
+  required_providers {
+    stax = {
+      source = "registry.terraform.io/stax-labs/stax"
+    }
+  }
+}
+
+provider "stax" {
+}
@@ -0,0 +1,15 @@
+resource "stax_catalogue_item" "vpc" {
+  name        = "vpc"
+  description = "A two tier VPC with flow logs"
+  version     = "1.0.0"
+
+  manifest_body = file("${path.module}/manifest.yml")
+
+  parameters = {
+    CidrBlock = "10.0.0.0/16"
+  }
+
+  tags = {
+    "Owner" = "platform"
+  }
+}
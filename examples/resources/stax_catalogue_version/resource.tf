@@ -0,0 +1,10 @@
+variable "catalogue_id" {
+  description = "the identifier of the workload catalogue item to publish the version to"
+}
+
+resource "stax_catalogue_version" "vpc-1-1-0" {
+  catalogue_id = var.catalogue_id
+  version      = "1.1.0"
+  description  = "Adds VPC endpoints for S3 and DynamoDB"
+  manifest_url = "s3://{StaxArtifactBucket}/workload-vpc/manifest-1.1.0.yml"
+}
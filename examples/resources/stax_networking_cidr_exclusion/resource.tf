@@ -0,0 +1,14 @@
+variable "networking_hub_id" {
+  description = "the identifier of the networking hub to add the cidr exclusion to"
+}
+
+resource "stax_networking_cidr_exclusion" "on_premise" {
+  networking_hub_id = var.networking_hub_id
+  name              = "on-premise"
+  description       = "cidr used by the on premise network"
+  cidr              = "10.1.128.0/24"
+
+  tags = {
+    "CostCode" = "12345"
+  }
+}
@@ -0,0 +1,14 @@
+variable "networking_hub_id" {
+  description = "the identifier of the networking hub to add the cidr range to"
+}
+
+resource "stax_networking_cidr_range" "workloads" {
+  networking_hub_id = var.networking_hub_id
+  name              = "workloads"
+  description       = "cidr range for workload vpcs"
+  cidr              = "10.1.0.0/16"
+
+  tags = {
+    "CostCode" = "12345"
+  }
+}
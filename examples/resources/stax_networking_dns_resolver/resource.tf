@@ -0,0 +1,13 @@
+variable "networking_hub_id" {
+  description = "the identifier of the networking hub to create the dns resolver in"
+}
+
+resource "stax_networking_dns_resolver" "core" {
+  networking_hub_id    = var.networking_hub_id
+  name                 = "core-resolver"
+  number_of_interfaces = 2
+
+  tags = {
+    "CostCode" = "12345"
+  }
+}
@@ -0,0 +1,14 @@
+variable "dns_resolver_id" {
+  description = "the identifier of the dns resolver to add the rule to"
+}
+
+resource "stax_networking_dns_rule" "on_premise" {
+  dns_resolver_id        = var.dns_resolver_id
+  name                   = "on-premise"
+  domain_name            = "corp.example.com"
+  forwarder_ip_addresses = ["192.168.0.2", "192.168.0.3"]
+
+  tags = {
+    "CostCode" = "12345"
+  }
+}
@@ -0,0 +1,23 @@
+variable "account_id" {
+  description = "the identifier of the stax account to deploy the networking hub to"
+}
+
+resource "stax_networking_hub" "core" {
+  name        = "core-hub"
+  description = "shared transit hub for ap-southeast-2"
+  account_id  = var.account_id
+  region      = "ap-southeast-2"
+  cidr        = "10.0.0.0/16"
+
+  create_internet_gateway = true
+  create_nat_gateway      = true
+
+  tags = {
+    "CostCode" = "12345"
+  }
+
+  timeouts {
+    create = "1h"
+    delete = "1h"
+  }
+}
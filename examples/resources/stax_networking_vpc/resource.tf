@@ -0,0 +1,31 @@
+variable "account_id" {
+  description = "the identifier of the stax account to create the vpc in"
+}
+
+variable "networking_hub_id" {
+  description = "the identifier of the networking hub to connect the vpc to"
+}
+
+variable "cidr_range_id" {
+  description = "the identifier of the networking hub cidr range to allocate the vpc cidr from"
+}
+
+resource "stax_networking_vpc" "workloads" {
+  networking_hub_id = var.networking_hub_id
+  name              = "workloads"
+  description       = "production workloads"
+  account_id        = var.account_id
+  region            = "ap-southeast-2"
+  cidr_range_id     = var.cidr_range_id
+  size              = "MEDIUM"
+  type              = "FLAT"
+  zone              = "production"
+
+  create_flow_logs  = true
+  gateway_endpoints = ["s3", "dynamodb"]
+  phz_prefix        = "workloads"
+
+  tags = {
+    "CostCode" = "12345"
+  }
+}
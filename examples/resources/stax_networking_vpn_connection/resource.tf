@@ -0,0 +1,20 @@
+variable "vpn_customer_gateway_id" {
+  description = "the identifier of the vpn customer gateway to connect"
+}
+
+variable "networking_hub_id" {
+  description = "the identifier of the networking hub to connect the vpn to"
+}
+
+resource "stax_networking_vpn_connection" "office" {
+  vpn_customer_gateway_id = var.vpn_customer_gateway_id
+  networking_hub_id       = var.networking_hub_id
+  name                    = "office"
+
+  wait_for_tunnels_up = true
+  tunnels_up_timeout  = "45m"
+
+  tags = {
+    "CostCode" = "12345"
+  }
+}
@@ -0,0 +1,15 @@
+variable "account_id" {
+  description = "the identifier of the stax account to create the customer gateway in"
+}
+
+resource "stax_networking_vpn_customer_gateway" "office" {
+  name       = "office"
+  account_id = var.account_id
+  region     = "ap-southeast-2"
+  asn        = 65000
+  ip_address = "203.0.113.10"
+
+  tags = {
+    "CostCode" = "12345"
+  }
+}
@@ -0,0 +1,26 @@
+variable "account_id" {
+  description = "the identifier of the stax account to deploy the workload to"
+}
+
+variable "catalogue_id" {
+  description = "the identifier of the workload catalogue item to deploy"
+}
+
+resource "stax_workload" "data-lake" {
+  name         = "data-lake"
+  account_id   = var.account_id
+  catalogue_id = var.catalogue_id
+  region       = "ap-southeast-2"
+
+  parameters = {
+    BucketName = "presentation-dev-data-lake"
+  }
+
+  tags = {
+    "CostCode" = "12345"
+  }
+
+  timeouts {
+    create = "1h"
+  }
+}
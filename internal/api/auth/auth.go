@@ -2,8 +2,10 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/cognito"
@@ -30,10 +32,20 @@ func (a *APIToken) IsValid() bool {
 	return a.AccessKey != "" && a.SecretKey != ""
 }
 
+// RefreshFn refreshes an authenticated session and returns a new AuthResponse.
+type RefreshFn func(ctx context.Context) (*AuthResponse, error)
+
 // AuthResponse authentication response containing the aws sdk configuration loaded with credentials.
 type AuthResponse struct {
 	AWSConfig aws.Config
 	Tokens    *cognito.IDPTokens
+
+	// TokensExpire is when the IDP tokens expire, this is zero if the expiry is unknown.
+	TokensExpire time.Time
+
+	// Refresh refreshes the session using the Cognito refresh token, falling back to a full authentication
+	// if the refresh token is rejected. This is nil if the session can't be refreshed.
+	Refresh RefreshFn
 }
 
 func AuthAPIToken(ctx context.Context, client client.ClientWithResponsesInterface, apiToken *APIToken) (*AuthResponse, error) {
@@ -42,6 +54,8 @@ func AuthAPIToken(ctx context.Context, client client.ClientWithResponsesInterfac
 		return nil, err
 	}
 
+	issued := time.Now()
+
 	result, err := cognito.Auth(ctx,
 		cognito.WithRegion(string(publicConfig.ApiAuth.Region)),
 		cognito.WithUserPool(publicConfig.ApiAuth.UserPoolId, publicConfig.ApiAuth.UserPoolWebClientId),
@@ -50,18 +64,46 @@ func AuthAPIToken(ctx context.Context, client client.ClientWithResponsesInterfac
 		return nil, err
 	}
 
+	return newAuthResponse(ctx, client, apiToken, publicConfig, result.IDPTokens, issued)
+}
+
+// newAuthResponse exchanges the ID token for AWS credentials and returns an AuthResponse which can be refreshed.
+func newAuthResponse(ctx context.Context, client client.ClientWithResponsesInterface, apiToken *APIToken, publicConfig *models.PublicReadConfig, tokens *cognito.IDPTokens, issued time.Time) (*AuthResponse, error) {
 	cfg, err := sts.GetCredentials(ctx,
 		sts.WithRegion(string(publicConfig.ApiAuth.Region)),
 		sts.WithUserPool(publicConfig.ApiAuth.UserPoolId, publicConfig.ApiAuth.IdentityPoolId),
-		sts.WithIDToken(*result.IDPTokens.IdToken))
+		sts.WithIDToken(aws.ToString(tokens.IdToken)))
 	if err != nil {
 		return nil, err
 	}
 
-	return &AuthResponse{
+	authResponse := &AuthResponse{
 		AWSConfig: cfg,
-		Tokens:    result.IDPTokens,
-	}, nil
+		Tokens:    tokens,
+	}
+
+	if tokens.ExpiresIn > 0 {
+		authResponse.TokensExpire = issued.Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	}
+
+	authResponse.Refresh = func(ctx context.Context) (*AuthResponse, error) {
+		issued := time.Now()
+
+		result, err := cognito.Refresh(ctx,
+			cognito.WithRegion(string(publicConfig.ApiAuth.Region)),
+			cognito.WithUserPool(publicConfig.ApiAuth.UserPoolId, publicConfig.ApiAuth.UserPoolWebClientId),
+			cognito.WithRefreshToken(aws.ToString(tokens.RefreshToken)))
+		if errors.Is(err, cognito.ErrRefreshTokenRejected) {
+			return AuthAPIToken(ctx, client, apiToken)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return newAuthResponse(ctx, client, apiToken, publicConfig, result.IDPTokens, issued)
+	}
+
+	return authResponse, nil
 }
 
 func getPublicConfig(ctx context.Context, client client.ClientWithResponsesInterface) (*models.PublicReadConfig, error) {
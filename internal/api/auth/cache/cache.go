@@ -0,0 +1,274 @@
+// This package provides an on-disk cache of authenticated sessions which is shared between provider processes.
+package cache
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/cognito"
+)
+
+// DefaultMinLifetime is the minimum remaining lifetime of a cached session for it to be reused.
+const DefaultMinLifetime = 10 * time.Minute
+
+// cacheVersion is incremented when the format of the cached entries changes.
+const cacheVersion = 1
+
+// ErrInvalidEntry is returned when a cached entry can't be decrypted or decoded.
+var ErrInvalidEntry = errors.New("invalid credential cache entry")
+
+// entry is the cached session, this is encrypted before it is written to disk.
+type entry struct {
+	Version         int
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	CanExpire       bool
+	Expires         time.Time
+	IDToken         string
+	TokensExpire    time.Time
+}
+
+// FileCache caches the ID token and temporary AWS credentials of authenticated sessions on disk.
+//
+// Each entry is stored in its own file, named using a hash of the installation and API token access key, which is
+// only readable by the current user. The entry is encrypted with AES-GCM using a key derived from the API token
+// secret key, so it can only be read by a caller which already holds the API token.
+type FileCache struct {
+	dir         string
+	minLifetime time.Duration
+	now         func() time.Time
+}
+
+// Option configures the FileCache.
+type Option func(*FileCache)
+
+// WithMinLifetime sets the minimum remaining lifetime of a cached session for it to be reused.
+func WithMinLifetime(minLifetime time.Duration) Option {
+	return func(c *FileCache) {
+		c.minLifetime = minLifetime
+	}
+}
+
+// NewFileCache creates a FileCache which stores entries in dir.
+func NewFileCache(dir string, opts ...Option) *FileCache {
+	c := &FileCache{
+		dir:         dir,
+		minLifetime: DefaultMinLifetime,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// DefaultDir returns the default cache directory, "stax/credentials" within the user cache directory.
+func DefaultDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, "stax", "credentials"), nil
+}
+
+// Load returns the cached session for the installation and API token, the second return value is false
+// if there is no entry or it expires within the minimum lifetime.
+func (c *FileCache) Load(installation string, apiToken *auth.APIToken) (*auth.AuthResponse, bool) {
+	data, err := os.ReadFile(c.path(installation, apiToken))
+	if err != nil {
+		return nil, false
+	}
+
+	e, err := decrypt(apiToken, data)
+	if err != nil {
+		return nil, false
+	}
+
+	if e.Version != cacheVersion || !c.valid(e) {
+		return nil, false
+	}
+
+	creds := aws.Credentials{
+		AccessKeyID:     e.AccessKeyID,
+		SecretAccessKey: e.SecretAccessKey,
+		SessionToken:    e.SessionToken,
+		Source:          "StaxCredentialCache",
+		CanExpire:       e.CanExpire,
+		Expires:         e.Expires,
+	}
+
+	return &auth.AuthResponse{
+		AWSConfig: aws.Config{
+			Region: e.Region,
+			Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+				return creds, nil
+			}),
+		},
+		Tokens: &cognito.IDPTokens{
+			IdToken: aws.String(e.IDToken),
+		},
+		TokensExpire: e.TokensExpire,
+	}, true
+}
+
+// Store writes the session to the cache, replacing any existing entry for the installation and API token.
+func (c *FileCache) Store(ctx context.Context, installation string, apiToken *auth.APIToken, authResponse *auth.AuthResponse) error {
+	if authResponse.AWSConfig.Credentials == nil {
+		return errors.New("missing credentials, nothing to cache")
+	}
+
+	creds, err := authResponse.AWSConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+
+	e := entry{
+		Version:         cacheVersion,
+		Region:          authResponse.AWSConfig.Region,
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		CanExpire:       creds.CanExpire,
+		Expires:         creds.Expires,
+		TokensExpire:    authResponse.TokensExpire,
+	}
+
+	if authResponse.Tokens != nil {
+		e.IDToken = aws.ToString(authResponse.Tokens.IdToken)
+	}
+
+	data, err := encrypt(apiToken, e)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+
+	// write to a temporary file and rename it so concurrent readers never see a partial entry
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), c.path(installation, apiToken))
+}
+
+// Delete removes the cached entry for the installation and API token.
+func (c *FileCache) Delete(installation string, apiToken *auth.APIToken) error {
+	err := os.Remove(c.path(installation, apiToken))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (c *FileCache) valid(e *entry) bool {
+	minExpires := c.now().Add(c.minLifetime)
+
+	if e.CanExpire && !minExpires.Before(e.Expires) {
+		return false
+	}
+
+	if !e.TokensExpire.IsZero() && !minExpires.Before(e.TokensExpire) {
+		return false
+	}
+
+	return e.AccessKeyID != ""
+}
+
+func (c *FileCache) path(installation string, apiToken *auth.APIToken) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%s", installation, apiToken.AccessKey)))
+
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json.enc")
+}
+
+func encrypt(apiToken *auth.APIToken, e entry) ([]byte, error) {
+	plaintext, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(apiToken)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, []byte(apiToken.AccessKey)), nil
+}
+
+func decrypt(apiToken *auth.APIToken, data []byte) (*entry, error) {
+	gcm, err := newGCM(apiToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, ErrInvalidEntry
+	}
+
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, []byte(apiToken.AccessKey))
+	if err != nil {
+		return nil, ErrInvalidEntry
+	}
+
+	e := &entry{}
+	if err := json.Unmarshal(plaintext, e); err != nil {
+		return nil, ErrInvalidEntry
+	}
+
+	return e, nil
+}
+
+// newGCM derives the encryption key from the API token secret key.
+func newGCM(apiToken *auth.APIToken) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte("stax-credential-cache:" + apiToken.SecretKey))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/cognito"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileCache(t *testing.T) {
+	apiToken := &auth.APIToken{AccessKey: "b549185e-0fd7-44cf-a7b5-0751c720c0f0", SecretKey: "secret"}
+	expires := time.Now().Add(time.Hour).Round(0).UTC()
+
+	authResponse := &auth.AuthResponse{
+		AWSConfig: aws.Config{
+			Region: "ap-southeast-2",
+			Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+				return aws.Credentials{
+					AccessKeyID:     "access-key-id",
+					SecretAccessKey: "secret-access-key",
+					SessionToken:    "session-token",
+					CanExpire:       true,
+					Expires:         expires,
+				}, nil
+			}),
+		},
+		Tokens:       &cognito.IDPTokens{IdToken: aws.String("id-token")},
+		TokensExpire: expires,
+	}
+
+	t.Run("stores and loads a session", func(t *testing.T) {
+		c := NewFileCache(t.TempDir())
+
+		assert.NoError(t, c.Store(context.Background(), "au1", apiToken, authResponse))
+
+		loaded, ok := c.Load("au1", apiToken)
+		assert.True(t, ok)
+		assert.Equal(t, "ap-southeast-2", loaded.AWSConfig.Region)
+		assert.Equal(t, "id-token", aws.ToString(loaded.Tokens.IdToken))
+		assert.Equal(t, expires, loaded.TokensExpire)
+
+		creds, err := loaded.AWSConfig.Credentials.Retrieve(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "access-key-id", creds.AccessKeyID)
+		assert.Equal(t, "secret-access-key", creds.SecretAccessKey)
+		assert.Equal(t, expires, creds.Expires)
+	})
+
+	t.Run("entries are only readable by the current user", func(t *testing.T) {
+		c := NewFileCache(t.TempDir())
+
+		assert.NoError(t, c.Store(context.Background(), "au1", apiToken, authResponse))
+
+		info, err := os.Stat(c.path("au1", apiToken))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("entries are keyed by installation and access key", func(t *testing.T) {
+		c := NewFileCache(t.TempDir())
+
+		assert.NoError(t, c.Store(context.Background(), "au1", apiToken, authResponse))
+
+		_, ok := c.Load("us1", apiToken)
+		assert.False(t, ok)
+
+		_, ok = c.Load("au1", &auth.APIToken{AccessKey: "6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e", SecretKey: "secret"})
+		assert.False(t, ok)
+	})
+
+	t.Run("entries can't be read with a different secret key", func(t *testing.T) {
+		c := NewFileCache(t.TempDir())
+
+		assert.NoError(t, c.Store(context.Background(), "au1", apiToken, authResponse))
+
+		_, ok := c.Load("au1", &auth.APIToken{AccessKey: apiToken.AccessKey, SecretKey: "other"})
+		assert.False(t, ok)
+	})
+
+	t.Run("entries expiring within the minimum lifetime are ignored", func(t *testing.T) {
+		c := NewFileCache(t.TempDir(), WithMinLifetime(10*time.Minute))
+		c.now = func() time.Time { return expires.Add(-5 * time.Minute) }
+
+		assert.NoError(t, c.Store(context.Background(), "au1", apiToken, authResponse))
+
+		_, ok := c.Load("au1", apiToken)
+		assert.False(t, ok)
+	})
+
+	t.Run("deletes entries", func(t *testing.T) {
+		c := NewFileCache(t.TempDir())
+
+		assert.NoError(t, c.Store(context.Background(), "au1", apiToken, authResponse))
+		assert.NoError(t, c.Delete("au1", apiToken))
+		assert.NoError(t, c.Delete("au1", apiToken))
+
+		_, ok := c.Load("au1", apiToken)
+		assert.False(t, ok)
+	})
+}
@@ -3,6 +3,7 @@ package cognito
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,6 +15,9 @@ import (
 	"github.com/aws/smithy-go/logging"
 )
 
+// ErrRefreshTokenRejected is returned by Refresh when Cognito rejects the refresh token, for example because it has expired or been revoked.
+var ErrRefreshTokenRejected = errors.New("refresh token rejected")
+
 type CognitoIdentityProviderClient interface {
 	InitiateAuth(ctx context.Context, params *cognitoidentityprovider.InitiateAuthInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.InitiateAuthOutput, error)
 	RespondToAuthChallenge(ctx context.Context, params *cognitoidentityprovider.RespondToAuthChallengeInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.RespondToAuthChallengeOutput, error)
@@ -54,6 +58,7 @@ type CognitoConfig struct {
 	userPoolID          string
 	userPoolWebClientID string
 	username, password  string
+	refreshToken        string
 	cipsvc              CognitoIdentityProviderClient
 	csrp                CognitoSRP
 	logger              logging.Logger
@@ -85,6 +90,13 @@ func WithUsernamePassword(username, password string) AuthOption {
 	}
 }
 
+// WithRefreshToken sets the refresh token used by Refresh.
+func WithRefreshToken(refreshToken string) AuthOption {
+	return func(cfg *CognitoConfig) {
+		cfg.refreshToken = refreshToken
+	}
+}
+
 // WithCognitoIdentityProviderClient sets the CognitoIdentityProvider client.
 func WithCognitoIdentityProviderClient(cipsvc CognitoIdentityProviderClient) AuthOption {
 	return func(cfg *CognitoConfig) {
@@ -125,31 +137,11 @@ func WithLogger(logger logging.Logger) AuthOption {
 // The AuthResult contains the AWS config and IDP tokens from the successful authentication.
 
 func Auth(ctx context.Context, opts ...AuthOption) (*AuthResult, error) {
-	cfg := CognitoConfig{}
-
-	for _, opt := range opts {
-		opt(&cfg)
-	}
-
-	if cfg.logger == nil {
-		cfg.logger = logging.Nop{}
-	}
-
-	awscfg, err := config.LoadDefaultConfig(
-		ctx,
-		config.WithRegion(cfg.region),
-		config.WithCredentialsProvider(aws.AnonymousCredentials{}),
-		config.WithLogger(cfg.logger),
-		config.WithClientLogMode(aws.LogRetries|aws.LogRequest),
-	)
+	cfg, awscfg, err := loadConfig(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	if cfg.cipsvc == nil {
-		cfg.cipsvc = cognitoidentityprovider.NewFromConfig(awscfg)
-	}
-
 	if cfg.csrp == nil {
 		csrp, err := cognitosrp.NewCognitoSRP(cfg.username, cfg.password, cfg.userPoolID, cfg.userPoolWebClientID, nil)
 		if err != nil {
@@ -197,3 +189,88 @@ func Auth(ctx context.Context, opts ...AuthOption) (*AuthResult, error) {
 		},
 	}, nil
 }
+
+//	Refresh exchanges a refresh token for new IDP tokens using the REFRESH_TOKEN_AUTH flow, avoiding a full SRP authentication.
+//
+// opts are the configuration options for the refresh, WithRefreshToken and WithUserPool are required.
+//
+// Cognito does not return a new refresh token, so the provided refresh token is returned in the IDP tokens.
+//
+// If Cognito rejects the refresh token the returned error wraps ErrRefreshTokenRejected, callers should fall back to Auth.
+func Refresh(ctx context.Context, opts ...AuthOption) (*AuthResult, error) {
+	cfg, awscfg, err := loadConfig(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.refreshToken == "" {
+		return nil, fmt.Errorf("%w: refresh token is empty", ErrRefreshTokenRejected)
+	}
+
+	resp, err := cfg.cipsvc.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeRefreshTokenAuth,
+		ClientId: aws.String(cfg.userPoolWebClientID),
+		AuthParameters: map[string]string{
+			"REFRESH_TOKEN": cfg.refreshToken,
+		},
+	})
+	if err != nil {
+		var notAuthorizedErr *types.NotAuthorizedException
+		if errors.As(err, &notAuthorizedErr) {
+			return nil, fmt.Errorf("%w: %s", ErrRefreshTokenRejected, err)
+		}
+
+		return nil, err
+	}
+
+	if resp.AuthenticationResult == nil {
+		return nil, fmt.Errorf("failed refresh, unhandled challenge: %s", resp.ChallengeName)
+	}
+
+	refreshToken := resp.AuthenticationResult.RefreshToken
+	if refreshToken == nil {
+		refreshToken = aws.String(cfg.refreshToken)
+	}
+
+	return &AuthResult{
+		AWSConfig: awscfg,
+		IDPTokens: &IDPTokens{
+			IdToken:      resp.AuthenticationResult.IdToken,
+			AccessToken:  resp.AuthenticationResult.AccessToken,
+			RefreshToken: refreshToken,
+			ExpiresIn:    resp.AuthenticationResult.ExpiresIn,
+			TokenType:    resp.AuthenticationResult.TokenType,
+		},
+	}, nil
+}
+
+// loadConfig applies the options and loads the default AWS config with anonymous credentials, constructing the
+// CognitoIdentityProvider client if it was not provided.
+func loadConfig(ctx context.Context, opts ...AuthOption) (CognitoConfig, aws.Config, error) {
+	cfg := CognitoConfig{}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.logger == nil {
+		cfg.logger = logging.Nop{}
+	}
+
+	awscfg, err := config.LoadDefaultConfig(
+		ctx,
+		config.WithRegion(cfg.region),
+		config.WithCredentialsProvider(aws.AnonymousCredentials{}),
+		config.WithLogger(cfg.logger),
+		config.WithClientLogMode(aws.LogRetries|aws.LogRequest),
+	)
+	if err != nil {
+		return cfg, aws.Config{}, err
+	}
+
+	if cfg.cipsvc == nil {
+		cfg.cipsvc = cognitoidentityprovider.NewFromConfig(awscfg)
+	}
+
+	return cfg, awscfg, nil
+}
@@ -51,13 +51,55 @@ func TestAuth(t *testing.T) {
 	})
 }
 
+func TestRefresh(t *testing.T) {
+	t.Run("refreshes tokens successfully", func(t *testing.T) {
+		cipsvc := &mockCognitoIdentityProviderClient{
+			initiateAuthOutput: &cognitoidentityprovider.InitiateAuthOutput{
+				AuthenticationResult: &types.AuthenticationResultType{
+					AccessToken: aws.String("access-token"),
+					ExpiresIn:   3600,
+					IdToken:     aws.String("id-token"),
+				},
+			},
+		}
+
+		result, err := Refresh(context.Background(),
+			WithUserPool("eu-west-1_myPool", "123abd"),
+			WithRefreshToken("refresh-token"),
+			WithRegion("us-east-1"),
+			WithCognitoIdentityProviderClient(cipsvc))
+		assert.NoError(t, err)
+		assert.Equal(t, types.AuthFlowTypeRefreshTokenAuth, cipsvc.initiateAuthInput.AuthFlow)
+		assert.Equal(t, "123abd", aws.ToString(cipsvc.initiateAuthInput.ClientId))
+		assert.Equal(t, map[string]string{"REFRESH_TOKEN": "refresh-token"}, cipsvc.initiateAuthInput.AuthParameters)
+		assert.Equal(t, "id-token", aws.ToString(result.IDPTokens.IdToken))
+		assert.Equal(t, "refresh-token", aws.ToString(result.IDPTokens.RefreshToken))
+	})
+
+	t.Run("returns ErrRefreshTokenRejected when not authorized", func(t *testing.T) {
+		cipsvc := &mockCognitoIdentityProviderClient{
+			initiateAuthErr: &types.NotAuthorizedException{Message: aws.String("Refresh Token has expired")},
+		}
+
+		_, err := Refresh(context.Background(),
+			WithUserPool("eu-west-1_myPool", "123abd"),
+			WithRefreshToken("refresh-token"),
+			WithRegion("us-east-1"),
+			WithCognitoIdentityProviderClient(cipsvc))
+		assert.ErrorIs(t, err, ErrRefreshTokenRejected)
+	})
+}
+
 type mockCognitoIdentityProviderClient struct {
 	initiateAuthOutput           *cognitoidentityprovider.InitiateAuthOutput
+	initiateAuthErr              error
+	initiateAuthInput            *cognitoidentityprovider.InitiateAuthInput
 	respondToAuthChallengeOutput *cognitoidentityprovider.RespondToAuthChallengeOutput
 }
 
 func (m *mockCognitoIdentityProviderClient) InitiateAuth(ctx context.Context, params *cognitoidentityprovider.InitiateAuthInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.InitiateAuthOutput, error) {
-	return m.initiateAuthOutput, nil
+	m.initiateAuthInput = params
+	return m.initiateAuthOutput, m.initiateAuthErr
 }
 
 func (m *mockCognitoIdentityProviderClient) RespondToAuthChallenge(ctx context.Context, params *cognitoidentityprovider.RespondToAuthChallengeInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.RespondToAuthChallengeOutput, error) {
@@ -0,0 +1,151 @@
+// This package sources Stax API tokens from an external credential process.
+package process
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+)
+
+// DefaultTimeout is the maximum time the credential process may run for.
+const DefaultTimeout = time.Minute
+
+// DefaultExpiryWindow is how long before the API token expires that the credential process is run again.
+const DefaultExpiryWindow = time.Minute
+
+var (
+	// ErrInvalidOutput is returned when the credential process does not print the expected JSON.
+	ErrInvalidOutput = errors.New("invalid credential process output")
+)
+
+// output is the JSON printed by the credential process.
+type output struct {
+	// Version is optional and must be 1 if provided.
+	Version int `json:"Version"`
+
+	AccessKey string `json:"AccessKey"`
+	SecretKey string `json:"SecretKey"`
+
+	// Expiration is optional, when provided the process is run again after the API token expires.
+	Expiration *time.Time `json:"Expiration"`
+}
+
+// Process runs an external command which prints an API token as JSON, for example:
+//
+//	{"Version": 1, "AccessKey": "0ab1c2d3-...", "SecretKey": "...", "Expiration": "2023-08-01T12:00:00Z"}
+//
+// The API token is cached until shortly before it expires. It is safe for concurrent use.
+type Process struct {
+	command      string
+	timeout      time.Duration
+	expiryWindow time.Duration
+	now          func() time.Time
+	runFunc      func(ctx context.Context, command string) ([]byte, error)
+
+	mu       sync.Mutex
+	apiToken *auth.APIToken
+	expires  *time.Time
+}
+
+// Option configures the Process.
+type Option func(*Process)
+
+// WithTimeout sets the maximum time the credential process may run for.
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Process) {
+		p.timeout = timeout
+	}
+}
+
+// WithExpiryWindow sets how long before the API token expires that the credential process is run again.
+func WithExpiryWindow(expiryWindow time.Duration) Option {
+	return func(p *Process) {
+		p.expiryWindow = expiryWindow
+	}
+}
+
+// New creates a Process which runs command using the system shell.
+func New(command string, opts ...Option) *Process {
+	p := &Process{
+		command:      command,
+		timeout:      DefaultTimeout,
+		expiryWindow: DefaultExpiryWindow,
+		now:          time.Now,
+		runFunc:      run,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Retrieve returns the API token, running the credential process if there is no token or it is about to expire.
+func (p *Process) Retrieve(ctx context.Context) (*auth.APIToken, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.apiToken != nil && (p.expires == nil || p.now().Add(p.expiryWindow).Before(*p.expires)) {
+		return p.apiToken, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	stdout, err := p.runFunc(ctx, p.command)
+	if err != nil {
+		return nil, fmt.Errorf("credential process failed: %w", err)
+	}
+
+	out := output{}
+	if err := json.Unmarshal(stdout, &out); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOutput, err)
+	}
+
+	if out.Version != 0 && out.Version != 1 {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidOutput, out.Version)
+	}
+
+	apiToken := &auth.APIToken{AccessKey: out.AccessKey, SecretKey: out.SecretKey}
+	if !apiToken.IsValid() {
+		return nil, fmt.Errorf("%w: missing AccessKey or SecretKey", ErrInvalidOutput)
+	}
+
+	p.apiToken = apiToken
+	p.expires = out.Expiration
+
+	return apiToken, nil
+}
+
+// run executes the command using the system shell, returning stdout. Stderr is included in the error if it fails.
+func run(ctx context.Context, command string) ([]byte, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,80 @@
+package process
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcess_Retrieve(t *testing.T) {
+	t.Run("runs the command", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses a posix shell")
+		}
+
+		p := New(`echo '{"Version": 1, "AccessKey": "b549185e-0fd7-44cf-a7b5-0751c720c0f0", "SecretKey": "secret"}'`)
+
+		apiToken, err := p.Retrieve(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &auth.APIToken{AccessKey: "b549185e-0fd7-44cf-a7b5-0751c720c0f0", SecretKey: "secret"}, apiToken)
+	})
+
+	t.Run("includes stderr when the command fails", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses a posix shell")
+		}
+
+		p := New(`echo "not logged in" >&2; exit 1`)
+
+		_, err := p.Retrieve(context.Background())
+		assert.ErrorContains(t, err, "not logged in")
+	})
+
+	t.Run("caches the token until it expires", func(t *testing.T) {
+		now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+		runs := 0
+
+		p := New("credential-helper", WithExpiryWindow(time.Minute))
+		p.now = func() time.Time { return now }
+		p.runFunc = func(ctx context.Context, command string) ([]byte, error) {
+			runs++
+			return []byte(`{"AccessKey": "b549185e-0fd7-44cf-a7b5-0751c720c0f0", "SecretKey": "secret", "Expiration": "2023-08-01T13:00:00Z"}`), nil
+		}
+
+		_, err := p.Retrieve(context.Background())
+		assert.NoError(t, err)
+
+		now = now.Add(58 * time.Minute)
+		_, err = p.Retrieve(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, runs)
+
+		now = now.Add(time.Minute)
+		_, err = p.Retrieve(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, runs)
+	})
+
+	t.Run("rejects invalid output", func(t *testing.T) {
+		tests := map[string]string{
+			"not json":            `AccessKey=abc`,
+			"missing secret key":  `{"AccessKey": "b549185e-0fd7-44cf-a7b5-0751c720c0f0"}`,
+			"unsupported version": `{"Version": 2, "AccessKey": "b549185e-0fd7-44cf-a7b5-0751c720c0f0", "SecretKey": "secret"}`,
+		}
+		for name, stdout := range tests {
+			t.Run(name, func(t *testing.T) {
+				p := New("credential-helper")
+				p.runFunc = func(ctx context.Context, command string) ([]byte, error) {
+					return []byte(stdout), nil
+				}
+
+				_, err := p.Retrieve(context.Background())
+				assert.ErrorIs(t, err, ErrInvalidOutput)
+			})
+		}
+	})
+}
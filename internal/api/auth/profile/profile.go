@@ -0,0 +1,135 @@
+// This package loads named profiles from the Stax shared credentials file.
+package profile
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfileName is the profile used when no profile is selected.
+const DefaultProfileName = "default"
+
+var (
+	// ErrProfileNotFound is returned when the selected profile is not in the shared credentials file.
+	ErrProfileNotFound = errors.New("profile not found in shared credentials file")
+)
+
+// Profile is a named profile from the shared credentials file.
+type Profile struct {
+	Name              string
+	Installation      string
+	APITokenAccessKey string
+	APITokenSecretKey string
+
+	// CredentialProcess is a command which prints the API token, this is used instead of the API token keys.
+	CredentialProcess string
+}
+
+// DefaultFilename returns the default location of the shared credentials file, "~/.stax/credentials".
+func DefaultFilename() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".stax", "credentials"), nil
+}
+
+//	Load reads the named profile from the shared credentials file.
+//
+// The file is INI formatted with a section for each profile, for example:
+//
+//	[default]
+//	installation         = au1
+//	api_token_access_key = 0ab1c2d3-...
+//	api_token_secret_key = ...
+//
+//	[secrets-manager]
+//	installation       = us1
+//	credential_process = stax-token-helper --tenancy us1
+//
+// Returns ErrProfileNotFound if the profile is not in the file, and an error matching os.ErrNotExist if the file does not exist.
+func Load(filename, name string) (*Profile, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse shared credentials file %s: %w", filename, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	return profile, nil
+}
+
+func parse(r io.Reader) (map[string]*Profile, error) {
+	profiles := map[string]*Profile{}
+
+	var current *Profile
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		// skip blank lines and comments
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid section header", lineNumber)
+			}
+
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+
+			current = &Profile{Name: name}
+			profiles[name] = current
+
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile section", lineNumber)
+		}
+
+		value = strings.TrimSpace(value)
+
+		switch strings.TrimSpace(key) {
+		case "installation":
+			current.Installation = value
+		case "api_token_access_key":
+			current.APITokenAccessKey = value
+		case "api_token_secret_key":
+			current.APITokenSecretKey = value
+		case "credential_process":
+			current.CredentialProcess = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,79 @@
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCredentialsFile = `
+# engineering tenancies
+[default]
+installation         = au1
+api_token_access_key = b549185e-0fd7-44cf-a7b5-0751c720c0f0
+api_token_secret_key = secretau1
+
+[us1-prod]
+installation=us1
+api_token_access_key=6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e
+api_token_secret_key=secretus1
+; unknown keys are ignored
+region = us-east-1
+
+[helper]
+installation = eu1
+credential_process = stax-token-helper --tenancy "eu1"
+`
+
+func TestLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	assert.NoError(t, os.WriteFile(filename, []byte(testCredentialsFile), 0o600))
+
+	t.Run("loads the default profile", func(t *testing.T) {
+		profile, err := Load(filename, DefaultProfileName)
+		assert.NoError(t, err)
+		assert.Equal(t, &Profile{
+			Name:              "default",
+			Installation:      "au1",
+			APITokenAccessKey: "b549185e-0fd7-44cf-a7b5-0751c720c0f0",
+			APITokenSecretKey: "secretau1",
+		}, profile)
+	})
+
+	t.Run("loads a named profile", func(t *testing.T) {
+		profile, err := Load(filename, "us1-prod")
+		assert.NoError(t, err)
+		assert.Equal(t, &Profile{
+			Name:              "us1-prod",
+			Installation:      "us1",
+			APITokenAccessKey: "6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e",
+			APITokenSecretKey: "secretus1",
+		}, profile)
+	})
+
+	t.Run("loads a credential process profile", func(t *testing.T) {
+		profile, err := Load(filename, "helper")
+		assert.NoError(t, err)
+		assert.Equal(t, `stax-token-helper --tenancy "eu1"`, profile.CredentialProcess)
+	})
+
+	t.Run("returns ErrProfileNotFound for an unknown profile", func(t *testing.T) {
+		_, err := Load(filename, "eu1")
+		assert.ErrorIs(t, err, ErrProfileNotFound)
+	})
+
+	t.Run("returns os.ErrNotExist for a missing file", func(t *testing.T) {
+		_, err := Load(filepath.Join(t.TempDir(), "missing"), DefaultProfileName)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("returns an error for an invalid file", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "credentials")
+		assert.NoError(t, os.WriteFile(invalid, []byte("installation = au1\n"), 0o600))
+
+		_, err := Load(invalid, DefaultProfileName)
+		assert.ErrorContains(t, err, "line 1: key outside of a profile section")
+	})
+}
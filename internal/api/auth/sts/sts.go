@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
 	"github.com/aws/smithy-go/logging"
 )
 
@@ -75,7 +76,7 @@ func WithLogger(logger logging.Logger) CredsOption {
 //
 // credsRes is the response from calling GetCredentialsForIdentity to get the temporary AWS credentials.
 //
-// The function returns the AWS config loaded with the temporary credentials.
+// The function returns the AWS config loaded with the temporary credentials, which expire at the time returned by Cognito Identity.
 func GetCredentials(ctx context.Context, opts ...CredsOption) (aws.Config, error) {
 
 	cfg := CredsConfig{}
@@ -126,10 +127,33 @@ func GetCredentials(ctx context.Context, opts ...CredsOption) (aws.Config, error
 	return config.LoadDefaultConfig(
 		ctx,
 		config.WithRegion(cfg.region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			aws.ToString(credsRes.Credentials.AccessKeyId),
-			aws.ToString(credsRes.Credentials.SecretKey),
-			aws.ToString(credsRes.Credentials.SessionToken),
-		)),
+		config.WithCredentialsProvider(newIdentityCredentialsProvider(credsRes.Credentials)),
 	)
 }
+
+// newIdentityCredentialsProvider returns a provider for the temporary credentials issued by Cognito Identity,
+// the credentials carry their expiration so callers can refresh them before they expire.
+func newIdentityCredentialsProvider(creds *types.Credentials) aws.CredentialsProvider {
+	staticCreds := credentials.NewStaticCredentialsProvider(
+		aws.ToString(creds.AccessKeyId),
+		aws.ToString(creds.SecretKey),
+		aws.ToString(creds.SessionToken),
+	)
+
+	if creds.Expiration == nil {
+		return staticCreds
+	}
+
+	return aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		value, err := staticCreds.Retrieve(ctx)
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+
+		value.Source = "CognitoIdentity"
+		value.CanExpire = true
+		value.Expires = aws.ToTime(creds.Expiration)
+
+		return value, nil
+	})
+}
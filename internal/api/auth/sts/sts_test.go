@@ -17,6 +17,7 @@ func TestGetCredentials(t *testing.T) {
 		identityPoolID := "identity-pool-id"
 		idToken := "id-token"
 		region := "us-east-1"
+		expiration := time.Now().Add(time.Hour).Round(0)
 
 		cisvc := &mockCognitoIdentityClient{
 			getIdOutput: &cognitoidentity.GetIdOutput{
@@ -27,7 +28,7 @@ func TestGetCredentials(t *testing.T) {
 					AccessKeyId:  aws.String("access-key-id"),
 					SecretKey:    aws.String("secret-key"),
 					SessionToken: aws.String("session-token"),
-					Expiration:   aws.Time(time.Now()),
+					Expiration:   aws.Time(expiration),
 				},
 			},
 		}
@@ -41,6 +42,12 @@ func TestGetCredentials(t *testing.T) {
 		assert.NotNil(t, cfg)
 		assert.Equal(t, region, cfg.Region)
 		assert.NotNil(t, cfg.Credentials)
+
+		creds, err := cfg.Credentials.Retrieve(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "access-key-id", creds.AccessKeyID)
+		assert.True(t, creds.CanExpire)
+		assert.Equal(t, expiration, creds.Expires)
 	})
 }
 
@@ -3,29 +3,124 @@ package helpers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"time"
 )
 
+// ErrTaskTimeout is returned when a task does not complete before the poller deadline.
+var ErrTaskTimeout = errors.New("timed out waiting for task to complete")
+
 type HTTPResponse interface {
 	StatusCode() int
 	Status() string
 }
 
+// Backoff returns the interval to wait before the next poll.
+type Backoff interface {
+	// Next returns the interval to wait after the given number of polls.
+	Next(attempt int) time.Duration
+}
+
+// ExponentialBackoff increases the interval between polls by Multiplier each attempt, up to MaxInterval.
+type ExponentialBackoff struct {
+	InitialInterval time.Duration
+	Multiplier      float64
+	MaxInterval     time.Duration
+}
+
+// Next returns InitialInterval * Multiplier^(attempt-1), capped at MaxInterval.
+func (b ExponentialBackoff) Next(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	interval := time.Duration(float64(b.InitialInterval) * math.Pow(multiplier, float64(attempt-1)))
+	if b.MaxInterval > 0 && (interval > b.MaxInterval || interval <= 0) {
+		return b.MaxInterval
+	}
+
+	return interval
+}
+
+// PollerConfig configures the polling interval and overall deadline of a TaskPoller.
+type PollerConfig struct {
+	// Backoff returns the interval between polls.
+	Backoff Backoff
+
+	// Timeout is the overall deadline for the task to complete, zero disables the deadline.
+	Timeout time.Duration
+}
+
+// DefaultPollerConfig returns the poller configuration used by NewTaskPoller.
+func DefaultPollerConfig() PollerConfig {
+	return PollerConfig{
+		Backoff: ExponentialBackoff{
+			InitialInterval: 5 * time.Second,
+			Multiplier:      1.5,
+			MaxInterval:     30 * time.Second,
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+// TaskTimeoutError is returned when a task does not complete before the poller deadline, it matches ErrTaskTimeout.
+type TaskTimeoutError struct {
+	// Timeout is the configured deadline for the task.
+	Timeout time.Duration
+
+	// LastStatus is the last status observed before the deadline, this may be empty.
+	LastStatus string
+}
+
+func (e *TaskTimeoutError) Error() string {
+	if e.LastStatus == "" {
+		return fmt.Sprintf("%s after %s", ErrTaskTimeout, e.Timeout)
+	}
+
+	return fmt.Sprintf("%s after %s, last status: %s", ErrTaskTimeout, e.Timeout, e.LastStatus)
+}
+
+// Is reports whether target is ErrTaskTimeout.
+func (e *TaskTimeoutError) Is(target error) bool {
+	return target == ErrTaskTimeout
+}
+
 // TaskPoller is a helper to poll an API for the status of an asynchronous task.
 // It will call the provided taskFunc to get the latest task status and will continue polling until
-// the task completes (succeeds or fails) or an error occurs.
+// the task completes (succeeds or fails), the deadline is reached or an error occurs.
 type TaskPoller[T HTTPResponse] struct {
-	taskFunc func() (T, error)
-	lastResp T
-	err      error // Sticky error.
+	taskFunc   func() (T, error)
+	statusFunc func(T) string
+	cfg        PollerConfig
+	attempt    int
+	deadline   time.Time
+	lastResp   T
+	err        error // Sticky error.
 }
 
-// NewTaskPoller creates a new TaskPoller.
+// NewTaskPoller creates a new TaskPoller using DefaultPollerConfig.
 // taskFunc is a function that will be called to get the latest status of the task. It should return
 // a HTTPResponse and an error.
 func NewTaskPoller[T HTTPResponse](taskFunc func() (T, error)) *TaskPoller[T] {
-	return &TaskPoller[T]{taskFunc: taskFunc}
+	return NewTaskPollerWithConfig(taskFunc, nil, DefaultPollerConfig())
+}
+
+// NewTaskPollerWithConfig creates a new TaskPoller with the provided backoff and deadline.
+// statusFunc is optional and is used to report the last observed status if the deadline is reached.
+func NewTaskPollerWithConfig[T HTTPResponse](taskFunc func() (T, error), statusFunc func(T) string, cfg PollerConfig) *TaskPoller[T] {
+	if cfg.Backoff == nil {
+		cfg.Backoff = DefaultPollerConfig().Backoff
+	}
+
+	return &TaskPoller[T]{taskFunc: taskFunc, statusFunc: statusFunc, cfg: cfg}
 }
 
 // Err returns the first error encountered while polling.
@@ -38,21 +133,79 @@ func (s *TaskPoller[T]) Resp() T {
 	return s.lastResp
 }
 
-// Poll calls taskFunc to get the latest task status. It will continue polling until the task completes
-// (succeeds or fails) or an error occurs.
+// Poll calls taskFunc to get the latest task status, waiting for the backoff interval before every poll after the first.
+// Waiting is cancelled when the context is done, in which case the context error is returned by Err.
 // Returns true if polling should continue, false otherwise.
 func (s *TaskPoller[T]) Poll(ctx context.Context) bool {
+	if s.err != nil {
+		return false
+	}
+
+	if s.attempt == 0 {
+		if s.cfg.Timeout > 0 {
+			s.deadline = time.Now().Add(s.cfg.Timeout)
+		}
+	} else if !s.wait(ctx) {
+		return false
+	}
+
+	s.attempt++
 
 	s.lastResp, s.err = s.taskFunc()
 	if s.err != nil {
 		return false
 	}
-	// TODO: Implement some basic error count back off
-	if s.lastResp.StatusCode() != http.StatusOK {
 
+	if s.lastResp.StatusCode() != http.StatusOK {
 		s.err = fmt.Errorf("request failed, returned non 200 status: %s", s.lastResp.Status())
 		return false
 	}
 
 	return true
 }
+
+func (s *TaskPoller[T]) wait(ctx context.Context) bool {
+	interval := s.cfg.Backoff.Next(s.attempt)
+
+	if !s.deadline.IsZero() {
+		remaining := time.Until(s.deadline)
+		if remaining <= 0 {
+			s.err = s.timeoutError()
+			return false
+		}
+
+		if interval > remaining {
+			interval = remaining
+		}
+	}
+
+	if err := SleepContext(ctx, interval); err != nil {
+		s.err = err
+		return false
+	}
+
+	return true
+}
+
+func (s *TaskPoller[T]) timeoutError() error {
+	timeoutErr := &TaskTimeoutError{Timeout: s.cfg.Timeout}
+
+	if s.statusFunc != nil {
+		timeoutErr.LastStatus = s.statusFunc(s.lastResp)
+	}
+
+	return timeoutErr
+}
+
+// SleepContext sleeps for the provided duration, returning early with the context error if it is cancelled.
+func SleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
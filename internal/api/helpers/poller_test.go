@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -47,6 +48,76 @@ func TestTaskPoller(t *testing.T) {
 	})
 }
 
+func TestTaskPollerWithConfig(t *testing.T) {
+	t.Run("waits between polls", func(t *testing.T) {
+		calls := 0
+		taskFunc := func() (HTTPResponse, error) {
+			calls++
+			return &mockHTTPResponse{statusCode: http.StatusOK}, nil
+		}
+		tp := NewTaskPollerWithConfig(taskFunc, nil, PollerConfig{
+			Backoff: ExponentialBackoff{InitialInterval: time.Millisecond, Multiplier: 2, MaxInterval: 5 * time.Millisecond},
+		})
+
+		for i := 0; i < 3; i++ {
+			assert.True(t, tp.Poll(context.Background()))
+		}
+		assert.Equal(t, 3, calls)
+		assert.Nil(t, tp.Err())
+	})
+
+	t.Run("returns task timeout error with last status", func(t *testing.T) {
+		taskFunc := func() (HTTPResponse, error) {
+			return &mockHTTPResponse{statusCode: http.StatusOK}, nil
+		}
+		tp := NewTaskPollerWithConfig(taskFunc, func(HTTPResponse) string { return "STARTED" }, PollerConfig{
+			Backoff: ExponentialBackoff{InitialInterval: 5 * time.Millisecond},
+			Timeout: 20 * time.Millisecond,
+		})
+
+		for tp.Poll(context.Background()) {
+		}
+
+		assert.ErrorIs(t, tp.Err(), ErrTaskTimeout)
+
+		var timeoutErr *TaskTimeoutError
+		assert.ErrorAs(t, tp.Err(), &timeoutErr)
+		assert.Equal(t, "STARTED", timeoutErr.LastStatus)
+	})
+
+	t.Run("returns context error when cancelled while waiting", func(t *testing.T) {
+		taskFunc := func() (HTTPResponse, error) {
+			return &mockHTTPResponse{statusCode: http.StatusOK}, nil
+		}
+		tp := NewTaskPollerWithConfig(taskFunc, nil, PollerConfig{
+			Backoff: ExponentialBackoff{InitialInterval: time.Minute},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		assert.True(t, tp.Poll(ctx))
+
+		cancel()
+
+		assert.False(t, tp.Poll(ctx))
+		assert.ErrorIs(t, tp.Err(), context.Canceled)
+	})
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff{
+		InitialInterval: time.Second,
+		Multiplier:      2,
+		MaxInterval:     10 * time.Second,
+	}
+
+	assert.Equal(t, time.Second, backoff.Next(1))
+	assert.Equal(t, 2*time.Second, backoff.Next(2))
+	assert.Equal(t, 8*time.Second, backoff.Next(4))
+	assert.Equal(t, 10*time.Second, backoff.Next(5))
+	assert.Equal(t, 10*time.Second, backoff.Next(100))
+}
+
 type mockHTTPResponse struct {
 	statusCode int
 }
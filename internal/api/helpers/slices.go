@@ -24,3 +24,16 @@ func Subtract(left map[string]bool, right map[string]bool) []string {
 
 	return s
 }
+
+//	ToSet takes a slice of strings and returns a map with each value as a key, for use with Subtract.
+//
+// vals is the slice of strings to convert.
+func ToSet(vals []string) map[string]bool {
+	m := make(map[string]bool, len(vals))
+
+	for _, val := range vals {
+		m[val] = true
+	}
+
+	return m
+}
@@ -24,3 +24,15 @@ func TestSubtract(t *testing.T) {
 
 	assert.Equal(expected, actual)
 }
+
+func TestToSet(t *testing.T) {
+	assert := require.New(t)
+
+	expected := map[string]bool{
+		"a": true,
+		"b": true,
+	}
+	actual := ToSet([]string{"a", "b", "a"})
+
+	assert.Equal(expected, actual)
+}
@@ -4,6 +4,7 @@ package staxsdk
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -77,8 +78,112 @@ type ClientInterface interface {
 	AccountTypeReadById(ctx context.Context, accountTypeID string) (*client.AccountsReadAccountTypeResp, error)
 	// AccountTypeRead reads account types and returns a client.AccountsReadAccountTypesResp.
 	AccountTypeRead(ctx context.Context, accountTypeIDs []string) (*client.AccountsReadAccountTypesResp, error)
+	// AccountTypeUpdatePolicies attaches and detaches policies on an account type and returns a client.AccountsUpdateAccountTypePoliciesResp.
+	AccountTypeUpdatePolicies(ctx context.Context, accountTypeID string, addPolicyIDs []string, removePolicyIDs []string) (*client.AccountsUpdateAccountTypePoliciesResp, error)
+	// AccountTypeUpdateAccess adds and removes the role mappings of account types and returns a client.AccountsUpdateAccountTypeAccessResp.
+	AccountTypeUpdateAccess(ctx context.Context, updateAccess models.AccountsUpdateAccountTypeAccess) (*client.AccountsUpdateAccountTypeAccessResp, error)
+	// AccountTypeUpdateMembers moves accounts between account types and returns a client.AccountsUpdateAccountTypeMembersResp.
+	AccountTypeUpdateMembers(ctx context.Context, updateMembers models.AccountsUpdateAccountTypeMembers) (*client.AccountsUpdateAccountTypeMembersResp, error)
+	// WorkloadCreate creates a workload and returns a client.WorkloadsCreateWorkloadResp.
+	WorkloadCreate(ctx context.Context, createWorkload models.WorkloadsCreateWorkload) (*client.WorkloadsCreateWorkloadResp, error)
+	// WorkloadReadByID reads a workload by ID and returns a client.WorkloadsReadWorkloadResp.
+	WorkloadReadByID(ctx context.Context, workloadID string) (*client.WorkloadsReadWorkloadResp, error)
+	// WorkloadRead reads workloads and returns a client.WorkloadsReadWorkloadsResp.
+	WorkloadRead(ctx context.Context, params *models.WorkloadsReadWorkloadsParams) (*client.WorkloadsReadWorkloadsResp, error)
+	// WorkloadUpdate updates a workload and returns a client.WorkloadsUpdateWorkloadResp.
+	WorkloadUpdate(ctx context.Context, workloadID string, updateWorkload models.WorkloadsUpdateWorkload) (*client.WorkloadsUpdateWorkloadResp, error)
 	// WorkloadDelete deletes a workload and returns a client.WorkloadsDeleteWorkloadResp.
 	WorkloadDelete(ctx context.Context, workloadID string) (*client.WorkloadsDeleteWorkloadResp, error)
+	// CatalogueItemCreate creates a workload catalogue item and returns a client.WorkloadsCreateCatalogueItemResp.
+	CatalogueItemCreate(ctx context.Context, createCatalogueItem models.WorkloadsCreateCatalogueItem) (*client.WorkloadsCreateCatalogueItemResp, error)
+	// CatalogueItemReadByID reads a workload catalogue item by ID and returns a models.WorkloadCatalogue.
+	CatalogueItemReadByID(ctx context.Context, catalogueID string) (*models.WorkloadCatalogue, error)
+	// CatalogueItemRead reads workload catalogue items and returns a slice of models.WorkloadCatalogue.
+	CatalogueItemRead(ctx context.Context, params *models.WorkloadsReadCatalogueItemsParams) ([]models.WorkloadCatalogue, error)
+	// CatalogueItemDelete deletes a workload catalogue item and returns a client.WorkloadsDeleteCatalogueItemResp.
+	CatalogueItemDelete(ctx context.Context, catalogueID string) (*client.WorkloadsDeleteCatalogueItemResp, error)
+	// CatalogueVersionCreate creates a workload catalogue version and returns a client.WorkloadsCreateCatalogueVersionResp.
+	CatalogueVersionCreate(ctx context.Context, catalogueID string, createCatalogueVersion models.WorkloadsCreateCatalogueVersion) (*client.WorkloadsCreateCatalogueVersionResp, error)
+	// CatalogueVersionReadByID reads a workload catalogue version by ID and returns a client.WorkloadsReadCatalogueVersionResp.
+	CatalogueVersionReadByID(ctx context.Context, catalogueID, versionID string) (*client.WorkloadsReadCatalogueVersionResp, error)
+	// CatalogueManifestRead reads the manifest location of a workload catalogue version and returns a client.WorkloadsReadCatalogueManifestResp.
+	CatalogueManifestRead(ctx context.Context, versionID string) (*client.WorkloadsReadCatalogueManifestResp, error)
+	// CatalogueTemplateRead reads the template location of a workload catalogue version and returns a client.WorkloadsReadCatalogueTemplateResp.
+	CatalogueTemplateRead(ctx context.Context, versionID, name string) (*client.WorkloadsReadCatalogueTemplateResp, error)
+	// CatalogueVersionDelete deletes a workload catalogue version and returns a client.WorkloadsDeleteCatalogueVersionResp.
+	CatalogueVersionDelete(ctx context.Context, catalogueID, versionID string) (*client.WorkloadsDeleteCatalogueVersionResp, error)
+	// NetworkingHubCreate creates a networking hub and returns a client.NetworkingCreateHubResp.
+	NetworkingHubCreate(ctx context.Context, createHub models.NetworkingCreateHub) (*client.NetworkingCreateHubResp, error)
+	// NetworkingHubReadByID reads a networking hub by ID and returns a client.NetworkingReadHubResp.
+	NetworkingHubReadByID(ctx context.Context, hubID string) (*client.NetworkingReadHubResp, error)
+	// NetworkingHubUpdate updates a networking hub and returns a client.NetworkingUpdateHubResp.
+	NetworkingHubUpdate(ctx context.Context, hubID string, updateHub models.NetworkingUpdateHub) (*client.NetworkingUpdateHubResp, error)
+	// NetworkingHubDelete deletes a networking hub and returns a client.NetworkingDeleteHubResp.
+	NetworkingHubDelete(ctx context.Context, hubID string) (*client.NetworkingDeleteHubResp, error)
+	// NetworkingVpcCreate creates a VPC in a networking hub and returns a client.NetworkingCreateVpcResp.
+	NetworkingVpcCreate(ctx context.Context, hubID string, createVpc models.NetworkingCreateVpc) (*client.NetworkingCreateVpcResp, error)
+	// NetworkingVpcReadByID reads a VPC by ID and returns a client.NetworkingReadVpcResp.
+	NetworkingVpcReadByID(ctx context.Context, vpcID string) (*client.NetworkingReadVpcResp, error)
+	// NetworkingVpcUpdate updates a VPC and returns a client.NetworkingUpdateVpcResp.
+	NetworkingVpcUpdate(ctx context.Context, vpcID string, updateVpc models.NetworkingUpdateVpc) (*client.NetworkingUpdateVpcResp, error)
+	// NetworkingVpcDelete deletes a VPC and returns a client.NetworkingDeleteVpcResp.
+	NetworkingVpcDelete(ctx context.Context, vpcID string) (*client.NetworkingDeleteVpcResp, error)
+	// NetworkingCidrRangeCreate creates a CIDR range in a networking hub and returns a client.NetworkingCreateCidrRangeResp.
+	NetworkingCidrRangeCreate(ctx context.Context, hubID string, createCidrRange models.NetworkingCreateCidrRange) (*client.NetworkingCreateCidrRangeResp, error)
+	// NetworkingCidrRangeReadByID reads a CIDR range by ID and returns a client.NetworkingReadCidrRangeResp.
+	NetworkingCidrRangeReadByID(ctx context.Context, rangeID string) (*client.NetworkingReadCidrRangeResp, error)
+	// NetworkingCidrRangeReadByHub reads the active CIDR ranges of a networking hub and returns a client.NetworkingReadHubCidrRangesResp.
+	NetworkingCidrRangeReadByHub(ctx context.Context, hubID string) (*client.NetworkingReadHubCidrRangesResp, error)
+	// NetworkingCidrRangeUpdate updates a CIDR range and returns a client.NetworkingUpdateCidrRangeResp.
+	NetworkingCidrRangeUpdate(ctx context.Context, rangeID string, updateCidrRange models.NetworkingUpdateCidrRange) (*client.NetworkingUpdateCidrRangeResp, error)
+	// NetworkingCidrRangeDelete deletes a CIDR range and returns a client.NetworkingDeleteCidrRangeResp.
+	NetworkingCidrRangeDelete(ctx context.Context, rangeID string) (*client.NetworkingDeleteCidrRangeResp, error)
+	// NetworkingCidrExclusionCreate creates a CIDR exclusion in a networking hub and returns a client.NetworkingCreateCidrExclusionResp.
+	NetworkingCidrExclusionCreate(ctx context.Context, hubID string, createCidrExclusion models.NetworkingCreateCidrExclusion) (*client.NetworkingCreateCidrExclusionResp, error)
+	// NetworkingCidrExclusionReadByID reads a CIDR exclusion by ID and returns a client.NetworkingReadCidrExclusionResp.
+	NetworkingCidrExclusionReadByID(ctx context.Context, exclusionID string) (*client.NetworkingReadCidrExclusionResp, error)
+	// NetworkingCidrExclusionReadByHub reads the active CIDR exclusions of a networking hub and returns a client.NetworkingReadHubCidrExclusionsResp.
+	NetworkingCidrExclusionReadByHub(ctx context.Context, hubID string) (*client.NetworkingReadHubCidrExclusionsResp, error)
+	// NetworkingCidrExclusionUpdate updates a CIDR exclusion and returns a client.NetworkingUpdateCidrExclusionResp.
+	NetworkingCidrExclusionUpdate(ctx context.Context, exclusionID string, updateCidrExclusion models.NetworkingUpdateCidrExclusion) (*client.NetworkingUpdateCidrExclusionResp, error)
+	// NetworkingCidrExclusionDelete deletes a CIDR exclusion and returns a client.NetworkingDeleteCidrExclusionResp.
+	NetworkingCidrExclusionDelete(ctx context.Context, exclusionID string) (*client.NetworkingDeleteCidrExclusionResp, error)
+	// NetworkingDnsResolverCreate creates a DNS resolver in a networking hub and returns a client.NetworkingCreateDnsResolverResp.
+	NetworkingDnsResolverCreate(ctx context.Context, hubID string, createDnsResolver models.NetworkingCreateDnsResolver) (*client.NetworkingCreateDnsResolverResp, error)
+	// NetworkingDnsResolverReadByID reads a DNS resolver by ID and returns a client.NetworkingReadDnsResolverResp.
+	NetworkingDnsResolverReadByID(ctx context.Context, resolverID string) (*client.NetworkingReadDnsResolverResp, error)
+	// NetworkingDnsResolverUpdate updates a DNS resolver and returns a client.NetworkingUpdateDnsResolverResp.
+	NetworkingDnsResolverUpdate(ctx context.Context, resolverID string, updateDnsResolver models.NetworkingUpdateDnsResolver) (*client.NetworkingUpdateDnsResolverResp, error)
+	// NetworkingDnsResolverDelete deletes a DNS resolver and returns a client.NetworkingDeleteDnsResolverResp.
+	NetworkingDnsResolverDelete(ctx context.Context, resolverID string) (*client.NetworkingDeleteDnsResolverResp, error)
+	// NetworkingDnsRuleCreate creates a DNS rule in a DNS resolver and returns a client.NetworkingCreateDnsRuleResp.
+	NetworkingDnsRuleCreate(ctx context.Context, resolverID string, createDnsRule models.NetworkingCreateDnsRule) (*client.NetworkingCreateDnsRuleResp, error)
+	// NetworkingDnsRuleReadByID reads a DNS rule by ID and returns a client.NetworkingReadDnsRuleResp.
+	NetworkingDnsRuleReadByID(ctx context.Context, ruleID string) (*client.NetworkingReadDnsRuleResp, error)
+	// NetworkingDnsRuleReadByResolver reads the active DNS rules of a DNS resolver and returns a client.NetworkingReadResolverDnsRulesResp.
+	NetworkingDnsRuleReadByResolver(ctx context.Context, resolverID string) (*client.NetworkingReadResolverDnsRulesResp, error)
+	// NetworkingDnsRuleUpdate updates a DNS rule and returns a client.NetworkingUpdateDnsRuleResp.
+	NetworkingDnsRuleUpdate(ctx context.Context, ruleID string, updateDnsRule models.NetworkingUpdateDnsRule) (*client.NetworkingUpdateDnsRuleResp, error)
+	// NetworkingDnsRuleDelete deletes a DNS rule and returns a client.NetworkingDeleteDnsRuleResp.
+	NetworkingDnsRuleDelete(ctx context.Context, ruleID string) (*client.NetworkingDeleteDnsRuleResp, error)
+	// NetworkingVpnCustomerGatewayCreate creates a VPN customer gateway and returns a client.NetworkingCreateVpnCustomerGatewayResp.
+	NetworkingVpnCustomerGatewayCreate(ctx context.Context, createVpnCustomerGateway models.NetworkingCreateVpnCustomerGateway) (*client.NetworkingCreateVpnCustomerGatewayResp, error)
+	// NetworkingVpnCustomerGatewayReadByID reads a VPN customer gateway by ID and returns a client.NetworkingReadVpnCustomerGatewayResp.
+	NetworkingVpnCustomerGatewayReadByID(ctx context.Context, customerGatewayID string) (*client.NetworkingReadVpnCustomerGatewayResp, error)
+	// NetworkingVpnCustomerGatewayUpdate updates a VPN customer gateway and returns a client.NetworkingUpdateVpnCustomerGatewayResp.
+	NetworkingVpnCustomerGatewayUpdate(ctx context.Context, customerGatewayID string, updateVpnCustomerGateway models.NetworkingUpdateVpnCustomerGateway) (*client.NetworkingUpdateVpnCustomerGatewayResp, error)
+	// NetworkingVpnCustomerGatewayDelete deletes a VPN customer gateway and returns a client.NetworkingDeleteVpnCustomerGatewayResp.
+	NetworkingVpnCustomerGatewayDelete(ctx context.Context, customerGatewayID string) (*client.NetworkingDeleteVpnCustomerGatewayResp, error)
+	// NetworkingVpnConnectionCreate creates a VPN connection for a VPN customer gateway and returns a client.NetworkingCreateVpnConnectionResp.
+	NetworkingVpnConnectionCreate(ctx context.Context, customerGatewayID string, createVpnConnection models.NetworkingCreateVpnConnection) (*client.NetworkingCreateVpnConnectionResp, error)
+	// NetworkingVpnConnectionReadByID reads a VPN connection by ID and returns a client.NetworkingReadVpnConnectionResp.
+	NetworkingVpnConnectionReadByID(ctx context.Context, connectionID string) (*client.NetworkingReadVpnConnectionResp, error)
+	// NetworkingVpnConnectionReadStatus reads the tunnel status of a VPN connection and returns a client.NetworkingReadVpnConnectionStatusResp.
+	NetworkingVpnConnectionReadStatus(ctx context.Context, connectionID string) (*client.NetworkingReadVpnConnectionStatusResp, error)
+	// NetworkingVpnConnectionUpdate updates a VPN connection and returns a client.NetworkingUpdateVpnConnectionResp.
+	NetworkingVpnConnectionUpdate(ctx context.Context, connectionID string, updateVpnConnection models.NetworkingUpdateVpnConnection) (*client.NetworkingUpdateVpnConnectionResp, error)
+	// NetworkingVpnConnectionDelete deletes a VPN connection and returns a client.NetworkingDeleteVpnConnectionResp.
+	NetworkingVpnConnectionDelete(ctx context.Context, connectionID string) (*client.NetworkingDeleteVpnConnectionResp, error)
 	UserReadByID(ctx context.Context, userID string) (*client.TeamsReadUserResp, error)
 	UserRead(ctx context.Context, userIDs []string) (*client.TeamsReadUsersResp, error)
 	UserCreate(ctx context.Context, params models.TeamsCreateUser) (*client.TeamsCreateUserResp, error)
@@ -117,6 +222,10 @@ type ClientInterface interface {
 	MonitorTask(ctx context.Context, taskID string, callbackFunc func(context.Context, *client.TasksReadTaskResp) bool) (*client.TasksReadTaskResp, error)
 	//	MonitorPermissionSetAssignments polls an asynchronous assignment update and returns the final response.
 	MonitorPermissionSetAssignments(ctx context.Context, permissionSetID, assignmentID string, completionStatuses []permissionssetsmodels.AssignmentRecordStatus, params *permissionssetsmodels.ListPermissionSetAssignmentsParams, callbackFunc func(context.Context, *permissionssetsclient.ListPermissionSetAssignmentsResponse) bool) (*permissionssetsclient.ListPermissionSetAssignmentsResponse, error)
+	//	MonitorVpnConnectionTunnels polls the tunnel status of a VPN connection until every tunnel reports up.
+	MonitorVpnConnectionTunnels(ctx context.Context, connectionID string, timeout time.Duration, callbackFunc func(context.Context, *client.NetworkingReadVpnConnectionStatusResp) bool) (*client.NetworkingReadVpnConnectionStatusResp, error)
+	//	TaskPollTimeout returns the overall deadline for asynchronous tasks, zero if tasks are waited on indefinitely.
+	TaskPollTimeout() time.Duration
 }
 
 //	AuthFn is the authentication function used to authenticate a client.
@@ -186,6 +295,46 @@ func WithAuthRequestSigner(authRequestSigner client.RequestEditorFn) ClientOptio
 	}
 }
 
+// WithTaskPollerConfig sets the polling interval backoff and overall deadline used by MonitorTask
+// and MonitorPermissionSetAssignments.
+func WithTaskPollerConfig(pollerConfig helpers.PollerConfig) ClientOption {
+	return func(c *Client) {
+		c.pollerConfig = pollerConfig
+	}
+}
+
+// WithRetryConfig sets the retry configuration used for throttled and failed requests.
+//
+// Set MaxAttempts to 1 to disable retries.
+func WithRetryConfig(retryConfig RetryConfig) ClientOption {
+	return func(c *Client) {
+		c.retryConfig = retryConfig
+	}
+}
+
+// APITokenProvider provides the API token used to authenticate, for example from an external credential process.
+type APITokenProvider interface {
+	// Retrieve returns the current API token.
+	Retrieve(ctx context.Context) (*auth.APIToken, error)
+}
+
+// WithAPITokenProvider sets a provider which is called for the API token each time the client authenticates,
+// this allows the API token to be rotated when it expires. The API token passed to NewClient is used until the
+// first authentication.
+func WithAPITokenProvider(apiTokenProvider APITokenProvider) ClientOption {
+	return func(c *Client) {
+		c.apiTokenProvider = apiTokenProvider
+	}
+}
+
+// WithCredentialsRefreshWindow sets how long before the temporary AWS credentials expire that the client re-authenticates,
+// defaults to DefaultCredentialsRefreshWindow.
+func WithCredentialsRefreshWindow(refreshWindow time.Duration) ClientOption {
+	return func(c *Client) {
+		c.credentialsRefreshWindow = refreshWindow
+	}
+}
+
 var _ ClientInterface = &Client{}
 
 type Client struct {
@@ -199,6 +348,12 @@ type Client struct {
 	apiToken                  *auth.APIToken
 	authRequestSigner         func(ctx context.Context, req *http.Request) error
 	authFn                    AuthFn
+	retryConfig               RetryConfig
+	pollerConfig              helpers.PollerConfig
+	credentialsRefreshWindow  time.Duration
+	credentialCache           CredentialCache
+	apiTokenProvider          APITokenProvider
+	session                   *session
 }
 
 //	NewClient creates a new STAX API client.
@@ -217,21 +372,46 @@ type Client struct {
 // - WithAuthRequestSigner: Sets the request signer used to sign API Gateway requests.
 // - WithUserAgentVersion: Sets the user agent version used in requests.
 // - WithHttpClient: Sets the HTTP client used to make requests.
+// - WithRetryConfig: Sets the retry configuration for throttled and failed requests, defaults to DefaultRetryConfig.
+// - WithTaskPollerConfig: Sets the polling backoff and deadline for asynchronous tasks, defaults to helpers.DefaultPollerConfig.
+// - WithCredentialCache: Sets a cache used to share authenticated sessions between clients, disabled by default.
+// - WithAPITokenProvider: Sets a provider called for the API token each time the client authenticates.
+// - WithCredentialsRefreshWindow: Sets how long before the credentials expire that the client re-authenticates, defaults to DefaultCredentialsRefreshWindow.
 func NewClient(ctx context.Context, apiToken *auth.APIToken, opts ...ClientOption) (*Client, error) {
 	c := &Client{
 		apiToken:         apiToken,
 		authFn:           auth.AuthAPIToken,
 		httpClient:       http.DefaultClient,
 		userAgentVersion: "stax-golang-sdk/0.0.1",
+		retryConfig:      DefaultRetryConfig(),
+		pollerConfig:     helpers.DefaultPollerConfig(),
+
+		credentialsRefreshWindow: DefaultCredentialsRefreshWindow,
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	httpClient := c.buildHttpClient()
+
 	if c.apiToken == nil {
 		return nil, ErrMissingAPIToken
 	}
 
+	if c.credentialCache != nil {
+		// sessions are cached per installation, or per endpoint when testing
+		installation := c.installation
+		if installation == "" {
+			installation = c.endpointURL
+		}
+
+		c.authFn = cachedAuthFn(c.credentialCache, installation, c.authFn)
+	}
+
+	if c.apiTokenProvider != nil {
+		c.authFn = apiTokenProviderAuthFn(c.apiTokenProvider, c.authFn)
+	}
+
 	installationURLs, err := getInstallationURL(c.installation, installationURLs{
 		CoreAPIEndpointURL:        c.endpointURL,
 		PermissionSetsEndpointURL: c.permissionSetsEndpointURL,
@@ -241,7 +421,7 @@ func NewClient(ctx context.Context, apiToken *auth.APIToken, opts ...ClientOptio
 	}
 
 	if c.client == nil {
-		coreClient, err := client.NewClientWithResponses(installationURLs.CoreAPIEndpointURL, client.WithHTTPClient(c.httpClient), client.WithRequestEditorFn(buildUserAgentRequestEditor(c.userAgentVersion)))
+		coreClient, err := client.NewClientWithResponses(installationURLs.CoreAPIEndpointURL, client.WithHTTPClient(httpClient), client.WithRequestEditorFn(buildUserAgentRequestEditor(c.userAgentVersion)))
 		if err != nil {
 			return nil, err
 		}
@@ -250,7 +430,7 @@ func NewClient(ctx context.Context, apiToken *auth.APIToken, opts ...ClientOptio
 	}
 
 	if c.permissionSetsClient == nil {
-		permissionSetsClient, err := permissionssetsclient.NewClientWithResponses(installationURLs.PermissionSetsEndpointURL, permissionssetsclient.WithHTTPClient(c.httpClient), permissionssetsclient.WithRequestEditorFn(buildUserAgentRequestEditor(c.userAgentVersion)))
+		permissionSetsClient, err := permissionssetsclient.NewClientWithResponses(installationURLs.PermissionSetsEndpointURL, permissionssetsclient.WithHTTPClient(httpClient), permissionssetsclient.WithRequestEditorFn(buildUserAgentRequestEditor(c.userAgentVersion)))
 		if err != nil {
 			return nil, err
 		}
@@ -261,22 +441,100 @@ func NewClient(ctx context.Context, apiToken *auth.APIToken, opts ...ClientOptio
 	return c, nil
 }
 
-func (cl *Client) Authenticate(ctx context.Context) error {
-	authResponse, err := cl.authFn(ctx, cl.client, cl.apiToken)
-	if err != nil {
-		return err
+// buildHttpClient wraps the configured HTTP client transport with re-authentication on expired credentials and retries,
+// each retry is re-signed using the current auth request signer.
+func (cl *Client) buildHttpClient() *http.Client {
+	signerFunc := func() client.RequestEditorFn {
+		return cl.authRequestSigner
+	}
+
+	transport := cl.httpClient.Transport
+
+	if cl.retryConfig.MaxAttempts > 1 {
+		transport = newRetryTransport(transport, cl.retryConfig, signerFunc)
 	}
 
-	authRequestSigner, err := apigw.RequestSigner(authResponse.AWSConfig.Region, auth.AWSCredentialsRetrieverFn(authResponse.AWSConfig)), nil
+	httpClient := *cl.httpClient
+	httpClient.Transport = newReauthTransport(transport, func() *session {
+		return cl.session
+	}, signerFunc)
+
+	return &httpClient
+}
+
+//	Authenticate authenticates the client using the configured AuthFn.
+//
+// The temporary AWS credentials returned are refreshed when they are within the credentials refresh window of
+// expiring, or when a request is rejected because they have expired. The refresher returned by the AuthFn is used
+// when available, otherwise the AuthFn is run again.
+func (cl *Client) Authenticate(ctx context.Context) error {
+	sess := newSession(func(ctx context.Context) (*auth.AuthResponse, error) {
+		return cl.authFn(ctx, cl.client, cl.apiToken)
+	}, cl.credentialsRefreshWindow)
+
+	err := sess.Refresh(ctx, sess.Generation())
 	if err != nil {
 		return err
 	}
 
-	cl.authRequestSigner = authRequestSigner
+	cl.session = sess
+	cl.authRequestSigner = apigw.RequestSigner(sess.Region(), sess.Retrieve)
 
 	return nil
 }
 
+// apiTokenProviderAuthFn wraps the AuthFn, authenticating with the API token returned by the provider.
+//
+// Sessions are refreshed using their refresher until the provider returns a different API token, at which point
+// the client authenticates again with the new API token.
+func apiTokenProviderAuthFn(apiTokenProvider APITokenProvider, authFn AuthFn) AuthFn {
+	var authenticate AuthFn
+	var wrapRefresh func(client client.ClientWithResponsesInterface, apiToken *auth.APIToken, authResponse *auth.AuthResponse) *auth.AuthResponse
+
+	authenticate = func(ctx context.Context, client client.ClientWithResponsesInterface, _ *auth.APIToken) (*auth.AuthResponse, error) {
+		apiToken, err := apiTokenProvider.Retrieve(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		authResponse, err := authFn(ctx, client, apiToken)
+		if err != nil {
+			return nil, err
+		}
+
+		return wrapRefresh(client, apiToken, authResponse), nil
+	}
+
+	wrapRefresh = func(client client.ClientWithResponsesInterface, apiToken *auth.APIToken, authResponse *auth.AuthResponse) *auth.AuthResponse {
+		refresh := authResponse.Refresh
+		if refresh == nil {
+			return authResponse
+		}
+
+		authResponse.Refresh = func(ctx context.Context) (*auth.AuthResponse, error) {
+			current, err := apiTokenProvider.Retrieve(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			if *current != *apiToken {
+				return authenticate(ctx, client, current)
+			}
+
+			refreshed, err := refresh(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			return wrapRefresh(client, apiToken, refreshed), nil
+		}
+
+		return authResponse
+	}
+
+	return authenticate
+}
+
 //	PublicReadConfig reads the public configuration from the STAX API.
 //
 // ctx: The context to use for this request.
@@ -290,7 +548,7 @@ func (cl *Client) PublicReadConfig(ctx context.Context) (*client.PublicReadConfi
 		return nil, err
 	}
 
-	err = checkResponse(ctx, publicConfigResp, string(publicConfigResp.Body))
+	err = checkResponse(ctx, "PublicReadConfig", publicConfigResp.HTTPResponse, publicConfigResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -317,7 +575,7 @@ func (cl *Client) AccountCreate(ctx context.Context, createAccount models.Accoun
 		return nil, err
 	}
 
-	err = checkResponse(ctx, createResp, string(createResp.Body))
+	err = checkResponse(ctx, "AccountsCreateAccount", createResp.HTTPResponse, createResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -325,332 +583,1898 @@ func (cl *Client) AccountCreate(ctx context.Context, createAccount models.Accoun
 	return createResp, nil
 }
 
-//	AccountReadByID reads an account by ID from STAX.
+//	AccountReadByID reads an account by ID from STAX.
+//
+// ctx: The context to use for this request.
+// accountID: The ID of the account to read.
+//
+// Returns:
+// - readAccountRes: The response from the AccountsReadAccount API call.
+// - err: Any error that occurred.
+func (cl *Client) AccountReadByID(ctx context.Context, accountID string) (*client.AccountsReadAccountResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	readAccountRes, err := cl.client.AccountsReadAccountWithResponse(ctx, accountID, &models.AccountsReadAccountParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsReadAccount", readAccountRes.HTTPResponse, readAccountRes.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if len(readAccountRes.JSON200.Accounts) != 1 {
+		return nil, &NotFoundError{Resource: "account", ID: accountID}
+	}
+
+	return readAccountRes, nil
+}
+
+//	AccountRead reads accounts from STAX, walking all pages of results.
+//
+// ctx: The context to use for this request.
+// accountIDs: Optional list of account IDs to filter by.
+// accountNames: Optional list of account names to filter by.
+//
+// Returns:
+// - readAccountsRes: The response from the last AccountsReadAccounts API call, containing the accounts from every page.
+// - err: Any error that occurred.
+func (cl *Client) AccountRead(ctx context.Context, accountIDs []string, accountNames []string) (*client.AccountsReadAccountsResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	idFilter := helpers.CommaDelimitedOptionalValue(accountIDs)
+	accountNamesFilter := helpers.CommaDelimitedOptionalValue(accountNames)
+
+	var readAccountsRes *client.AccountsReadAccountsResp
+
+	pager := NewOffsetPager(func(ctx context.Context, offset int) ([]models.Account, *int, error) {
+		var err error
+
+		readAccountsRes, err = cl.client.AccountsReadAccountsWithResponse(ctx, &models.AccountsReadAccountsParams{
+			Filter:       aws.String(string(models.AccountStatusACTIVE)),
+			IncludeTags:  aws.Bool(true),
+			IdFilter:     idFilter,
+			AccountNames: accountNamesFilter,
+			Offset:       aws.Int(offset),
+			Limit:        aws.Int(DefaultPageSize),
+		}, cl.authRequestSigner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = checkResponse(ctx, "AccountsReadAccounts", readAccountsRes.HTTPResponse, readAccountsRes.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if readAccountsRes.JSON200 == nil {
+			return nil, nil, nil
+		}
+
+		return readAccountsRes.JSON200.Accounts, nextOffset(readAccountsRes.JSON200.Paging), nil
+	})
+
+	accounts, err := CollectPages(ctx, pager)
+	if err != nil {
+		return nil, err
+	}
+
+	// the last page may not have a body, so the accounts collected from every page are returned regardless
+	readAccountsRes.JSON200 = &models.AccountsReadAccounts{Accounts: accounts}
+
+	return readAccountsRes, nil
+}
+
+//	AccountUpdate updates an account in STAX.
+//
+// ctx: The context to use for this request.
+// accountID: The ID of the account to update.
+// updateAccount: The account update parameters.
+//
+// Returns:
+// - accountUpdateRes: The response from the AccountsUpdateAccount API call.
+// - err: Any error that occurred.
+func (cl *Client) AccountUpdate(ctx context.Context, accountID string, updateAccount models.AccountsUpdateAccount) (*client.AccountsUpdateAccountResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountUpdateRes, err := cl.client.AccountsUpdateAccountWithResponse(ctx, accountID, updateAccount, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsUpdateAccount", accountUpdateRes.HTTPResponse, accountUpdateRes.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountUpdateRes, nil
+}
+
+//	AccountClose closes an account in STAX.
+//
+// ctx: The context to use for this request.
+// accountID: The ID of the account to close.
+//
+// Returns:
+// - accountCloseResp: The response from the AccountsCloseAccount API call.
+// - err: Any error that occurred.
+func (cl *Client) AccountClose(ctx context.Context, accountID string) (*client.AccountsCloseAccountResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountCloseResp, err := cl.client.AccountsCloseAccountWithResponse(ctx, models.AccountsCloseAccount{
+		Id: accountID,
+	}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsCloseAccount", accountCloseResp.HTTPResponse, accountCloseResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountCloseResp, nil
+}
+
+//	AccountTypeReadById reads an account type by ID.
+//
+// ctx: The context for the request
+// accountTypeID: The ID of the account type to read
+//
+// It returns:
+//
+// - *client.AccountsReadAccountTypeResp: The response containing the requested account type
+// - error: Any error that occurred while making the request.
+func (cl *Client) AccountTypeReadById(ctx context.Context, accountTypeID string) (*client.AccountsReadAccountTypeResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountTypeResp, err := cl.client.AccountsReadAccountTypeWithResponse(ctx, accountTypeID, &models.AccountsReadAccountTypeParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsReadAccountType", accountTypeResp.HTTPResponse, accountTypeResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if len(accountTypeResp.JSON200.AccountTypes) != 1 {
+		return nil, &NotFoundError{Resource: "account type", ID: accountTypeID}
+	}
+
+	return accountTypeResp, nil
+}
+
+//	AccountTypeRead reads account types from STAX.
+//
+// ctx: The context to use for this request.
+// accountTypeIDs: A list of account type IDs to filter the results. If empty, all account types will be returned.
+//
+// Returns:
+// - accountTypesResp: The response from the AccountsReadAccountTypes API call.
+// - err: Any error that occurred.
+func (cl *Client) AccountTypeRead(ctx context.Context, accountTypeIDs []string) (*client.AccountsReadAccountTypesResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountTypesFilter := helpers.CommaDelimitedOptionalValue(accountTypeIDs)
+
+	// the account types API does not paginate results
+	accountTypesResp, err := cl.client.AccountsReadAccountTypesWithResponse(ctx, &models.AccountsReadAccountTypesParams{
+		IdFilter: accountTypesFilter,
+	}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsReadAccountTypes", accountTypesResp.HTTPResponse, accountTypesResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountTypesResp, nil
+}
+
+//	AccountTypeCreate creates an account type in STAX.
+//
+// ctx: The context to use for this request.
+// name: The name of the account type to create.
+//
+// Returns:
+// - accountTypeResp: The response from the AccountsCreateAccountType API call.
+// - err: Any error that occurred.
+func (cl *Client) AccountTypeCreate(ctx context.Context, name string) (*client.AccountsCreateAccountTypeResp, error) {
+	accountTypeResp, err := cl.client.AccountsCreateAccountTypeWithResponse(ctx, models.AccountsCreateAccountType{
+		Name: name,
+	}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsCreateAccountType", accountTypeResp.HTTPResponse, accountTypeResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountTypeResp, nil
+}
+
+//	AccountTypeUpdate updates an account type in STAX.
+//
+// ctx: The context to use for this request.
+// accountTypeID: The ID of the account type to update.
+// name: The new name of the account type.
+//
+// Returns:
+// - accountTypeUpdateResp: The response from the AccountsUpdateAccountType API call.
+// - err: Any error that occurred.
+func (cl *Client) AccountTypeUpdate(ctx context.Context, accountTypeID, name string) (*client.AccountsUpdateAccountTypeResp, error) {
+	accountTypeUpdateResp, err := cl.client.AccountsUpdateAccountTypeWithResponse(ctx, accountTypeID, models.AccountsUpdateAccountType{
+		Name: name,
+	}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsUpdateAccountType", accountTypeUpdateResp.HTTPResponse, accountTypeUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountTypeUpdateResp, nil
+}
+
+//	AccountTypeDelete deletes an account type in STAX.
+//
+// ctx: The context to use for this request.
+// accountTypeID: The ID of the account type to delete.
+//
+// Returns:
+// - accountTypeDeleteResp: The response from the AccountsDeleteAccountType API call.
+// - err: Any error that occurred.
+func (cl *Client) AccountTypeDelete(ctx context.Context, accountTypeID string) (*client.AccountsDeleteAccountTypeResp, error) {
+	accountTypeDeleteResp, err := cl.client.AccountsDeleteAccountTypeWithResponse(ctx, accountTypeID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsDeleteAccountType", accountTypeDeleteResp.HTTPResponse, accountTypeDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountTypeDeleteResp, nil
+}
+
+//	AccountTypeUpdatePolicies attaches and detaches policies on an account type in STAX.
+//
+// ctx: The context to use for this request.
+// accountTypeID: The ID of the account type to update.
+// addPolicyIDs: The IDs of the policies to attach to the account type.
+// removePolicyIDs: The IDs of the policies to detach from the account type.
+//
+// Returns:
+// - updatePoliciesResp: The response from the AccountsUpdateAccountTypePolicies API call, containing the task id of the update.
+// - err: Any error that occurred.
+func (cl *Client) AccountTypeUpdatePolicies(ctx context.Context, accountTypeID string, addPolicyIDs []string, removePolicyIDs []string) (*client.AccountsUpdateAccountTypePoliciesResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := models.AccountsUpdateAccountTypePolicies{
+		AddPolicies:    accountTypePolicyMap(accountTypeID, addPolicyIDs),
+		RemovePolicies: accountTypePolicyMap(accountTypeID, removePolicyIDs),
+	}
+
+	updatePoliciesResp, err := cl.client.AccountsUpdateAccountTypePoliciesWithResponse(ctx, params, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsUpdateAccountTypePolicies", updatePoliciesResp.HTTPResponse, updatePoliciesResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatePoliciesResp, nil
+}
+
+//	AccountTypeUpdateAccess adds and removes the mappings of Stax groups to AWS access roles for account types in STAX.
+//
+// ctx: The context to use for this request.
+// updateAccess: The role mappings to add and remove, each mapping identifies the account type, group and role.
+//
+// Returns:
+// - updateAccessResp: The response from the AccountsUpdateAccountTypeAccess API call, containing the task id of the update.
+// - err: Any error that occurred.
+func (cl *Client) AccountTypeUpdateAccess(ctx context.Context, updateAccess models.AccountsUpdateAccountTypeAccess) (*client.AccountsUpdateAccountTypeAccessResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateAccessResp, err := cl.client.AccountsUpdateAccountTypeAccessWithResponse(ctx, updateAccess, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsUpdateAccountTypeAccess", updateAccessResp.HTTPResponse, updateAccessResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateAccessResp, nil
+}
+
+//	AccountTypeUpdateMembers moves accounts to account types in STAX, all of the accounts are moved by a single task.
+//
+// ctx: The context to use for this request.
+// updateMembers: The members to update, each member identifies an account and the account type it is moved to.
+//
+// Returns:
+// - updateMembersResp: The response from the AccountsUpdateAccountTypeMembers API call, containing the task id of the update.
+// - err: Any error that occurred.
+func (cl *Client) AccountTypeUpdateMembers(ctx context.Context, updateMembers models.AccountsUpdateAccountTypeMembers) (*client.AccountsUpdateAccountTypeMembersResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMembersResp, err := cl.client.AccountsUpdateAccountTypeMembersWithResponse(ctx, updateMembers, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "AccountsUpdateAccountTypeMembers", updateMembersResp.HTTPResponse, updateMembersResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateMembersResp, nil
+}
+
+func accountTypePolicyMap(accountTypeID string, policyIDs []string) *[]models.AccountTypePolicyMap {
+	if len(policyIDs) == 0 {
+		return nil
+	}
+
+	policiesMap := []models.AccountTypePolicyMap{}
+
+	for _, policyID := range policyIDs {
+		policiesMap = append(policiesMap, models.AccountTypePolicyMap{
+			AccountTypeId: aws.String(accountTypeID),
+			PolicyId:      aws.String(policyID),
+		})
+	}
+
+	return &policiesMap
+}
+
+//	WorkloadCreate creates a new workload in STAX.
+//
+// ctx: The context to use for this request.
+// createWorkload: The details of the workload to create.
+//
+// Returns:
+// - workloadCreateResp: The response from the WorkloadsCreateWorkload API call.
+// - err: Any error that occurred.
+func (cl *Client) WorkloadCreate(ctx context.Context, createWorkload models.WorkloadsCreateWorkload) (*client.WorkloadsCreateWorkloadResp, error) {
+	workloadCreateResp, err := cl.client.WorkloadsCreateWorkloadWithResponse(ctx, createWorkload, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsCreateWorkload", workloadCreateResp.HTTPResponse, workloadCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return workloadCreateResp, nil
+}
+
+//	WorkloadRead reads workloads from STAX, walking all pages of results.
+//
+// ctx: The context to use for this request.
+// params: The parameters for filtering which workloads to read, Offset is ignored and Limit defaults to DefaultPageSize.
+//
+// Returns:
+// - workloadsReadResp: The response from the last WorkloadsReadWorkloads API call, containing the workloads from every page,
+// the body is replaced with the workloads from every page so it can be passed to WorkloadOutputs.
+// - err: Any error that occurred.
+func (cl *Client) WorkloadRead(ctx context.Context, params *models.WorkloadsReadWorkloadsParams) (*client.WorkloadsReadWorkloadsResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageParams := models.WorkloadsReadWorkloadsParams{}
+	if params != nil {
+		pageParams = *params
+	}
+
+	if pageParams.Limit == nil {
+		pageParams.Limit = aws.Int(DefaultPageSize)
+	}
+
+	var (
+		workloadsReadResp *client.WorkloadsReadWorkloadsResp
+		rawWorkloads      []json.RawMessage
+	)
+
+	pager := NewOffsetPager(func(ctx context.Context, offset int) ([]models.Workload, *int, error) {
+		pageParams.Offset = aws.Int(offset)
+
+		var err error
+
+		workloadsReadResp, err = cl.client.WorkloadsReadWorkloadsWithResponse(ctx, &pageParams, cl.authRequestSigner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = checkResponse(ctx, "WorkloadsReadWorkloads", workloadsReadResp.HTTPResponse, workloadsReadResp.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if workloadsReadResp.JSON200 == nil {
+			return nil, nil, nil
+		}
+
+		page, err := decodeWorkloadsPage(workloadsReadResp.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rawWorkloads = append(rawWorkloads, page...)
+
+		return workloadsReadResp.JSON200.Workloads, nextOffset(workloadsReadResp.JSON200.Paging), nil
+	})
+
+	workloads, err := CollectPages(ctx, pager)
+	if err != nil {
+		return nil, err
+	}
+
+	// the last page may not have a body, so the workloads collected from every page are returned regardless
+	workloadsReadResp.JSON200 = &models.WorkloadsReadWorkloadsResponse{Workloads: workloads}
+
+	workloadsReadResp.Body, err = json.Marshal(&workloadsPage{Workloads: rawWorkloads})
+	if err != nil {
+		return nil, err
+	}
+
+	return workloadsReadResp, nil
+}
+
+//	WorkloadReadByID reads a workload by ID from STAX.
+//
+// ctx: The context to use for this request.
+// workloadID: The ID of the workload to read.
+//
+// Returns:
+// - workloadReadResp: The response from the WorkloadsReadWorkload API call.
+// - err: A NotFoundError if the workload does not exist, or any other error that occurred.
+func (cl *Client) WorkloadReadByID(ctx context.Context, workloadID string) (*client.WorkloadsReadWorkloadResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workloadReadResp, err := cl.client.WorkloadsReadWorkloadWithResponse(ctx, workloadID, &models.WorkloadsReadWorkloadParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsReadWorkload", workloadReadResp.HTTPResponse, workloadReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if workloadReadResp.JSON200 == nil || len(workloadReadResp.JSON200.Workloads) != 1 {
+		return nil, &NotFoundError{Resource: "workload", ID: workloadID}
+	}
+
+	return workloadReadResp, nil
+}
+
+//	WorkloadUpdate updates a workload in STAX.
+//
+// ctx: The context to use for this request.
+// workloadID: The ID of the workload to update.
+// updateWorkload: The workload update parameters.
+//
+// Returns:
+// - workloadUpdateResp: The response from the WorkloadsUpdateWorkload API call, use EventTaskID with the body to monitor the update.
+// - err: Any error that occurred.
+func (cl *Client) WorkloadUpdate(ctx context.Context, workloadID string, updateWorkload models.WorkloadsUpdateWorkload) (*client.WorkloadsUpdateWorkloadResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workloadUpdateResp, err := cl.client.WorkloadsUpdateWorkloadWithResponse(ctx, workloadID, updateWorkload, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsUpdateWorkload", workloadUpdateResp.HTTPResponse, workloadUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return workloadUpdateResp, nil
+}
+
+//	WorkloadDelete deletes a workload in STAX.
+//
+// ctx: The context to use for this request.
+// workloadID: The ID of the workload to delete.
+//
+// Returns:
+// - workloadDeleteResp: The response from the WorkloadsDeleteWorkload API call.
+// - err: Any error that occurred.
+func (cl *Client) WorkloadDelete(ctx context.Context, workloadID string) (*client.WorkloadsDeleteWorkloadResp, error) {
+	workloadDeleteResp, err := cl.client.WorkloadsDeleteWorkloadWithResponse(ctx, workloadID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsDeleteWorkload", workloadDeleteResp.HTTPResponse, workloadDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return workloadDeleteResp, nil
+}
+
+//	CatalogueItemCreate creates a new workload catalogue item, along with its first version, in STAX.
+//
+// ctx: The context to use for this request.
+// createCatalogueItem: The details of the catalogue item to create.
+//
+// Returns:
+// - catalogueItemCreateResp: The response from the WorkloadsCreateCatalogueItem API call, use EventTaskID and EventCatalogueID with the body.
+// - err: Any error that occurred.
+func (cl *Client) CatalogueItemCreate(ctx context.Context, createCatalogueItem models.WorkloadsCreateCatalogueItem) (*client.WorkloadsCreateCatalogueItemResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueItemCreateResp, err := cl.client.WorkloadsCreateCatalogueItemWithResponse(ctx, createCatalogueItem, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsCreateCatalogueItem", catalogueItemCreateResp.HTTPResponse, catalogueItemCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogueItemCreateResp, nil
+}
+
+//	CatalogueItemReadByID reads a workload catalogue item, including its versions, by ID from STAX.
+//
+// ctx: The context to use for this request.
+// catalogueID: The ID of the catalogue item to read.
+//
+// Returns:
+// - catalogueItem: The catalogue item from the WorkloadsReadCatalogueItem API call.
+// - err: A NotFoundError if the catalogue item does not exist, or any other error that occurred.
+func (cl *Client) CatalogueItemReadByID(ctx context.Context, catalogueID string) (*models.WorkloadCatalogue, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueItemReadResp, err := cl.client.WorkloadsReadCatalogueItemWithResponse(ctx, catalogueID, &models.WorkloadsReadCatalogueItemParams{
+		IncludeVersions:   aws.Bool(true),
+		IncludeParameters: aws.Bool(true),
+		IncludeTags:       aws.Bool(true),
+	}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsReadCatalogueItem", catalogueItemReadResp.HTTPResponse, catalogueItemReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if catalogueItemReadResp.JSON200 != nil {
+		for _, catalogue := range catalogueItemReadResp.JSON200.WorkloadCatalogues {
+			for _, catalogueItem := range catalogue.WorkloadCatalogueItems {
+				if aws.ToString(catalogueItem.Id) == catalogueID {
+					return &catalogueItem, nil
+				}
+			}
+		}
+	}
+
+	return nil, &NotFoundError{Resource: "catalogue item", ID: catalogueID}
+}
+
+//	CatalogueItemRead reads workload catalogue items, including their versions, from STAX, walking all pages of results.
+//
+// ctx: The context to use for this request.
+// params: The parameters for filtering which catalogue items to read, Offset is ignored and Limit defaults to DefaultPageSize.
+//
+// Returns:
+// - catalogueItems: The catalogue items from every page of the WorkloadsReadCatalogueItems API call.
+// - err: Any error that occurred.
+func (cl *Client) CatalogueItemRead(ctx context.Context, params *models.WorkloadsReadCatalogueItemsParams) ([]models.WorkloadCatalogue, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageParams := models.WorkloadsReadCatalogueItemsParams{}
+	if params != nil {
+		pageParams = *params
+	}
+
+	if pageParams.Limit == nil {
+		pageParams.Limit = aws.Int(DefaultPageSize)
+	}
+
+	pager := NewOffsetPager(func(ctx context.Context, offset int) ([]models.WorkloadCatalogue, *int, error) {
+		pageParams.Offset = aws.Int(offset)
+
+		catalogueItemsReadResp, err := cl.client.WorkloadsReadCatalogueItemsWithResponse(ctx, &pageParams, cl.authRequestSigner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = checkResponse(ctx, "WorkloadsReadCatalogueItems", catalogueItemsReadResp.HTTPResponse, catalogueItemsReadResp.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if catalogueItemsReadResp.JSON200 == nil {
+			return nil, nil, nil
+		}
+
+		// the catalogue items are grouped by organisation, each group has its own paging
+		var (
+			catalogueItems []models.WorkloadCatalogue
+			next           *int
+		)
+
+		for _, catalogue := range catalogueItemsReadResp.JSON200.WorkloadCatalogues {
+			catalogueItems = append(catalogueItems, catalogue.WorkloadCatalogueItems...)
+
+			if offset := nextOffset(catalogue.Paging); offset != nil {
+				next = offset
+			}
+		}
+
+		return catalogueItems, next, nil
+	})
+
+	return CollectPages(ctx, pager)
+}
+
+//	CatalogueItemDelete deletes a workload catalogue item in STAX.
+//
+// ctx: The context to use for this request.
+// catalogueID: The ID of the catalogue item to delete.
+//
+// Returns:
+// - catalogueItemDeleteResp: The response from the WorkloadsDeleteCatalogueItem API call, use EventTaskID with the body to monitor the delete.
+// - err: Any error that occurred.
+func (cl *Client) CatalogueItemDelete(ctx context.Context, catalogueID string) (*client.WorkloadsDeleteCatalogueItemResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueItemDeleteResp, err := cl.client.WorkloadsDeleteCatalogueItemWithResponse(ctx, catalogueID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsDeleteCatalogueItem", catalogueItemDeleteResp.HTTPResponse, catalogueItemDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogueItemDeleteResp, nil
+}
+
+//	CatalogueVersionCreate creates a new version of a workload catalogue item in STAX.
+//
+// ctx: The context to use for this request.
+// catalogueID: The ID of the catalogue item to add the version to.
+// createCatalogueVersion: The details of the catalogue version to create.
+//
+// Returns:
+// - catalogueVersionCreateResp: The response from the WorkloadsCreateCatalogueVersion API call, use EventTaskID with the body to monitor the create.
+// - err: Any error that occurred.
+func (cl *Client) CatalogueVersionCreate(ctx context.Context, catalogueID string, createCatalogueVersion models.WorkloadsCreateCatalogueVersion) (*client.WorkloadsCreateCatalogueVersionResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueVersionCreateResp, err := cl.client.WorkloadsCreateCatalogueVersionWithResponse(ctx, catalogueID, createCatalogueVersion, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsCreateCatalogueVersion", catalogueVersionCreateResp.HTTPResponse, catalogueVersionCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogueVersionCreateResp, nil
+}
+
+//	CatalogueVersionReadByID reads a version of a workload catalogue item by ID from STAX.
+//
+// ctx: The context to use for this request.
+// catalogueID: The ID of the catalogue item.
+// versionID: The ID of the catalogue version to read.
+//
+// Returns:
+// - catalogueVersionReadResp: The response from the WorkloadsReadCatalogueVersion API call.
+// - err: A NotFoundError if the catalogue version does not exist, or any other error that occurred.
+func (cl *Client) CatalogueVersionReadByID(ctx context.Context, catalogueID, versionID string) (*client.WorkloadsReadCatalogueVersionResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueVersionReadResp, err := cl.client.WorkloadsReadCatalogueVersionWithResponse(ctx, catalogueID, versionID, &models.WorkloadsReadCatalogueVersionParams{
+		IncludeParameters: aws.Bool(true),
+	}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsReadCatalogueVersion", catalogueVersionReadResp.HTTPResponse, catalogueVersionReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if catalogueVersionReadResp.JSON200 == nil || len(catalogueVersionReadResp.JSON200.Versions) != 1 {
+		return nil, &NotFoundError{Resource: "catalogue version", ID: versionID}
+	}
+
+	return catalogueVersionReadResp, nil
+}
+
+//	CatalogueManifestRead reads the location of the manifest of a workload catalogue version from STAX.
+//
+// ctx: The context to use for this request.
+// versionID: The ID of the catalogue version.
+//
+// Returns:
+// - catalogueManifestReadResp: The response from the WorkloadsReadCatalogueManifest API call, containing a URL to download the manifest.
+// - err: Any error that occurred.
+func (cl *Client) CatalogueManifestRead(ctx context.Context, versionID string) (*client.WorkloadsReadCatalogueManifestResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueManifestReadResp, err := cl.client.WorkloadsReadCatalogueManifestWithResponse(ctx, versionID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsReadCatalogueManifest", catalogueManifestReadResp.HTTPResponse, catalogueManifestReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogueManifestReadResp, nil
+}
+
+//	CatalogueTemplateRead reads the location of a rendered template of a workload catalogue version from STAX.
+//
+// ctx: The context to use for this request.
+// versionID: The ID of the catalogue version.
+// name: The name of the template in the manifest.
+//
+// Returns:
+// - catalogueTemplateReadResp: The response from the WorkloadsReadCatalogueTemplate API call, containing a URL to download the template.
+// - err: Any error that occurred.
+func (cl *Client) CatalogueTemplateRead(ctx context.Context, versionID, name string) (*client.WorkloadsReadCatalogueTemplateResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueTemplateReadResp, err := cl.client.WorkloadsReadCatalogueTemplateWithResponse(ctx, versionID, name, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsReadCatalogueTemplate", catalogueTemplateReadResp.HTTPResponse, catalogueTemplateReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogueTemplateReadResp, nil
+}
+
+//	CatalogueVersionDelete deletes a version of a workload catalogue item in STAX.
+//
+// ctx: The context to use for this request.
+// catalogueID: The ID of the catalogue item.
+// versionID: The ID of the catalogue version to delete.
+//
+// Returns:
+// - catalogueVersionDeleteResp: The response from the WorkloadsDeleteCatalogueVersion API call, use EventTaskID with the body to monitor the delete.
+// - err: Any error that occurred.
+func (cl *Client) CatalogueVersionDelete(ctx context.Context, catalogueID, versionID string) (*client.WorkloadsDeleteCatalogueVersionResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogueVersionDeleteResp, err := cl.client.WorkloadsDeleteCatalogueVersionWithResponse(ctx, catalogueID, versionID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "WorkloadsDeleteCatalogueVersion", catalogueVersionDeleteResp.HTTPResponse, catalogueVersionDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogueVersionDeleteResp, nil
+}
+
+//	NetworkingHubCreate creates a new networking hub in STAX.
+//
+// ctx: The context to use for this request.
+// createHub: The details of the networking hub to create.
+//
+// Returns:
+// - hubCreateResp: The response from the NetworkingCreateHub API call, use EventTaskID with the body to monitor the create.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingHubCreate(ctx context.Context, createHub models.NetworkingCreateHub) (*client.NetworkingCreateHubResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hubCreateResp, err := cl.client.NetworkingCreateHubWithResponse(ctx, createHub, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingCreateHub", hubCreateResp.HTTPResponse, hubCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return hubCreateResp, nil
+}
+
+//	NetworkingHubReadByID reads a networking hub by ID from STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub to read.
+//
+// Returns:
+// - hubReadResp: The response from the NetworkingReadHub API call.
+// - err: A NotFoundError if the networking hub does not exist, or any other error that occurred.
+func (cl *Client) NetworkingHubReadByID(ctx context.Context, hubID string) (*client.NetworkingReadHubResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hubReadResp, err := cl.client.NetworkingReadHubWithResponse(ctx, hubID, &models.NetworkingReadHubParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadHub", hubReadResp.HTTPResponse, hubReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if hubReadResp.JSON200 == nil || len(hubReadResp.JSON200.Hubs) != 1 {
+		return nil, &NotFoundError{Resource: "networking hub", ID: hubID}
+	}
+
+	return hubReadResp, nil
+}
+
+//	NetworkingHubUpdate updates a networking hub in STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub to update.
+// updateHub: The networking hub update parameters.
+//
+// Returns:
+// - hubUpdateResp: The response from the NetworkingUpdateHub API call, use EventTaskID with the body to monitor the update.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingHubUpdate(ctx context.Context, hubID string, updateHub models.NetworkingUpdateHub) (*client.NetworkingUpdateHubResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hubUpdateResp, err := cl.client.NetworkingUpdateHubWithResponse(ctx, hubID, updateHub, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingUpdateHub", hubUpdateResp.HTTPResponse, hubUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return hubUpdateResp, nil
+}
+
+//	NetworkingHubDelete deletes a networking hub in STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub to delete.
+//
+// Returns:
+// - hubDeleteResp: The response from the NetworkingDeleteHub API call, use EventTaskID with the body to monitor the delete.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingHubDelete(ctx context.Context, hubID string) (*client.NetworkingDeleteHubResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hubDeleteResp, err := cl.client.NetworkingDeleteHubWithResponse(ctx, hubID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingDeleteHub", hubDeleteResp.HTTPResponse, hubDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return hubDeleteResp, nil
+}
+
+//	NetworkingVpcCreate creates a new VPC in a networking hub in STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub the VPC belongs to.
+// createVpc: The details of the VPC to create.
+//
+// Returns:
+// - vpcCreateResp: The response from the NetworkingCreateVpc API call, use EventTaskID with the body to monitor the create.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingVpcCreate(ctx context.Context, hubID string, createVpc models.NetworkingCreateVpc) (*client.NetworkingCreateVpcResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcCreateResp, err := cl.client.NetworkingCreateVpcWithResponse(ctx, hubID, createVpc, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingCreateVpc", vpcCreateResp.HTTPResponse, vpcCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpcCreateResp, nil
+}
+
+//	NetworkingVpcReadByID reads a VPC by ID from STAX.
+//
+// ctx: The context to use for this request.
+// vpcID: The ID of the VPC to read.
+//
+// Returns:
+// - vpcReadResp: The response from the NetworkingReadVpc API call.
+// - err: A NotFoundError if the VPC does not exist, or any other error that occurred.
+func (cl *Client) NetworkingVpcReadByID(ctx context.Context, vpcID string) (*client.NetworkingReadVpcResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcReadResp, err := cl.client.NetworkingReadVpcWithResponse(ctx, vpcID, &models.NetworkingReadVpcParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadVpc", vpcReadResp.HTTPResponse, vpcReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if vpcReadResp.JSON200 == nil || len(vpcReadResp.JSON200.Vpcs) != 1 {
+		return nil, &NotFoundError{Resource: "networking vpc", ID: vpcID}
+	}
+
+	return vpcReadResp, nil
+}
+
+//	NetworkingVpcUpdate updates a VPC in STAX.
+//
+// ctx: The context to use for this request.
+// vpcID: The ID of the VPC to update.
+// updateVpc: The VPC update parameters.
+//
+// Returns:
+// - vpcUpdateResp: The response from the NetworkingUpdateVpc API call, use EventTaskID with the body to monitor the update.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingVpcUpdate(ctx context.Context, vpcID string, updateVpc models.NetworkingUpdateVpc) (*client.NetworkingUpdateVpcResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcUpdateResp, err := cl.client.NetworkingUpdateVpcWithResponse(ctx, vpcID, updateVpc, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingUpdateVpc", vpcUpdateResp.HTTPResponse, vpcUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpcUpdateResp, nil
+}
+
+//	NetworkingVpcDelete deletes a VPC in STAX.
+//
+// ctx: The context to use for this request.
+// vpcID: The ID of the VPC to delete.
+//
+// Returns:
+// - vpcDeleteResp: The response from the NetworkingDeleteVpc API call, use EventTaskID with the body to monitor the delete.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingVpcDelete(ctx context.Context, vpcID string) (*client.NetworkingDeleteVpcResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcDeleteResp, err := cl.client.NetworkingDeleteVpcWithResponse(ctx, vpcID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingDeleteVpc", vpcDeleteResp.HTTPResponse, vpcDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpcDeleteResp, nil
+}
+
+//	NetworkingCidrRangeCreate creates a new CIDR range in a networking hub in STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub the CIDR range belongs to.
+// createCidrRange: The details of the CIDR range to create.
+//
+// Returns:
+// - cidrRangeCreateResp: The response from the NetworkingCreateCidrRange API call, containing the created CIDR range.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrRangeCreate(ctx context.Context, hubID string, createCidrRange models.NetworkingCreateCidrRange) (*client.NetworkingCreateCidrRangeResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrRangeCreateResp, err := cl.client.NetworkingCreateCidrRangeWithResponse(ctx, hubID, createCidrRange, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingCreateCidrRange", cidrRangeCreateResp.HTTPResponse, cidrRangeCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrRangeCreateResp, nil
+}
+
+//	NetworkingCidrRangeReadByID reads a CIDR range by ID from STAX.
+//
+// ctx: The context to use for this request.
+// rangeID: The ID of the CIDR range to read.
+//
+// Returns:
+// - cidrRangeReadResp: The response from the NetworkingReadCidrRange API call.
+// - err: A NotFoundError if the CIDR range does not exist, or any other error that occurred.
+func (cl *Client) NetworkingCidrRangeReadByID(ctx context.Context, rangeID string) (*client.NetworkingReadCidrRangeResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrRangeReadResp, err := cl.client.NetworkingReadCidrRangeWithResponse(ctx, rangeID, &models.NetworkingReadCidrRangeParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadCidrRange", cidrRangeReadResp.HTTPResponse, cidrRangeReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if cidrRangeReadResp.JSON200 == nil || len(cidrRangeReadResp.JSON200.Ranges) != 1 {
+		return nil, &NotFoundError{Resource: "networking cidr range", ID: rangeID}
+	}
+
+	return cidrRangeReadResp, nil
+}
+
+//	NetworkingCidrRangeReadByHub reads the active CIDR ranges of a networking hub from STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub.
+//
+// Returns:
+// - cidrRangesReadResp: The response from the NetworkingReadHubCidrRanges API call.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrRangeReadByHub(ctx context.Context, hubID string) (*client.NetworkingReadHubCidrRangesResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrRangesReadResp, err := cl.client.NetworkingReadHubCidrRangesWithResponse(ctx, hubID, &models.NetworkingReadHubCidrRangesParams{Status: aws.String("ACTIVE")}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadHubCidrRanges", cidrRangesReadResp.HTTPResponse, cidrRangesReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrRangesReadResp, nil
+}
+
+//	NetworkingCidrRangeUpdate updates a CIDR range in STAX.
+//
+// ctx: The context to use for this request.
+// rangeID: The ID of the CIDR range to update.
+// updateCidrRange: The CIDR range update parameters.
+//
+// Returns:
+// - cidrRangeUpdateResp: The response from the NetworkingUpdateCidrRange API call.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrRangeUpdate(ctx context.Context, rangeID string, updateCidrRange models.NetworkingUpdateCidrRange) (*client.NetworkingUpdateCidrRangeResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrRangeUpdateResp, err := cl.client.NetworkingUpdateCidrRangeWithResponse(ctx, rangeID, updateCidrRange, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingUpdateCidrRange", cidrRangeUpdateResp.HTTPResponse, cidrRangeUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrRangeUpdateResp, nil
+}
+
+//	NetworkingCidrRangeDelete deletes a CIDR range in STAX.
+//
+// ctx: The context to use for this request.
+// rangeID: The ID of the CIDR range to delete.
+//
+// Returns:
+// - cidrRangeDeleteResp: The response from the NetworkingDeleteCidrRange API call.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrRangeDelete(ctx context.Context, rangeID string) (*client.NetworkingDeleteCidrRangeResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrRangeDeleteResp, err := cl.client.NetworkingDeleteCidrRangeWithResponse(ctx, rangeID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingDeleteCidrRange", cidrRangeDeleteResp.HTTPResponse, cidrRangeDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrRangeDeleteResp, nil
+}
+
+//	NetworkingCidrExclusionCreate creates a new CIDR exclusion in a networking hub in STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub the CIDR exclusion belongs to.
+// createCidrExclusion: The details of the CIDR exclusion to create.
+//
+// Returns:
+// - cidrExclusionCreateResp: The response from the NetworkingCreateCidrExclusion API call, containing the created CIDR exclusion.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrExclusionCreate(ctx context.Context, hubID string, createCidrExclusion models.NetworkingCreateCidrExclusion) (*client.NetworkingCreateCidrExclusionResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrExclusionCreateResp, err := cl.client.NetworkingCreateCidrExclusionWithResponse(ctx, hubID, createCidrExclusion, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingCreateCidrExclusion", cidrExclusionCreateResp.HTTPResponse, cidrExclusionCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrExclusionCreateResp, nil
+}
+
+//	NetworkingCidrExclusionReadByID reads a CIDR exclusion by ID from STAX.
+//
+// ctx: The context to use for this request.
+// exclusionID: The ID of the CIDR exclusion to read.
+//
+// Returns:
+// - cidrExclusionReadResp: The response from the NetworkingReadCidrExclusion API call.
+// - err: A NotFoundError if the CIDR exclusion does not exist, or any other error that occurred.
+func (cl *Client) NetworkingCidrExclusionReadByID(ctx context.Context, exclusionID string) (*client.NetworkingReadCidrExclusionResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrExclusionReadResp, err := cl.client.NetworkingReadCidrExclusionWithResponse(ctx, exclusionID, &models.NetworkingReadCidrExclusionParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadCidrExclusion", cidrExclusionReadResp.HTTPResponse, cidrExclusionReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if cidrExclusionReadResp.JSON200 == nil || len(cidrExclusionReadResp.JSON200.Exclusions) != 1 {
+		return nil, &NotFoundError{Resource: "networking cidr exclusion", ID: exclusionID}
+	}
+
+	return cidrExclusionReadResp, nil
+}
+
+//	NetworkingCidrExclusionReadByHub reads the active CIDR exclusions of a networking hub from STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub.
+//
+// Returns:
+// - cidrExclusionsReadResp: The response from the NetworkingReadHubCidrExclusions API call.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrExclusionReadByHub(ctx context.Context, hubID string) (*client.NetworkingReadHubCidrExclusionsResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrExclusionsReadResp, err := cl.client.NetworkingReadHubCidrExclusionsWithResponse(ctx, hubID, &models.NetworkingReadHubCidrExclusionsParams{Status: aws.String("ACTIVE")}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadHubCidrExclusions", cidrExclusionsReadResp.HTTPResponse, cidrExclusionsReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrExclusionsReadResp, nil
+}
+
+//	NetworkingCidrExclusionUpdate updates a CIDR exclusion in STAX.
+//
+// ctx: The context to use for this request.
+// exclusionID: The ID of the CIDR exclusion to update.
+// updateCidrExclusion: The CIDR exclusion update parameters.
+//
+// Returns:
+// - cidrExclusionUpdateResp: The response from the NetworkingUpdateCidrExclusion API call.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrExclusionUpdate(ctx context.Context, exclusionID string, updateCidrExclusion models.NetworkingUpdateCidrExclusion) (*client.NetworkingUpdateCidrExclusionResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrExclusionUpdateResp, err := cl.client.NetworkingUpdateCidrExclusionWithResponse(ctx, exclusionID, updateCidrExclusion, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingUpdateCidrExclusion", cidrExclusionUpdateResp.HTTPResponse, cidrExclusionUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrExclusionUpdateResp, nil
+}
+
+//	NetworkingCidrExclusionDelete deletes a CIDR exclusion in STAX.
+//
+// ctx: The context to use for this request.
+// exclusionID: The ID of the CIDR exclusion to delete.
+//
+// Returns:
+// - cidrExclusionDeleteResp: The response from the NetworkingDeleteCidrExclusion API call.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingCidrExclusionDelete(ctx context.Context, exclusionID string) (*client.NetworkingDeleteCidrExclusionResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrExclusionDeleteResp, err := cl.client.NetworkingDeleteCidrExclusionWithResponse(ctx, exclusionID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingDeleteCidrExclusion", cidrExclusionDeleteResp.HTTPResponse, cidrExclusionDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return cidrExclusionDeleteResp, nil
+}
+
+//	NetworkingDnsResolverCreate creates a new DNS resolver in a networking hub in STAX.
+//
+// ctx: The context to use for this request.
+// hubID: The ID of the networking hub the DNS resolver belongs to.
+// createDnsResolver: The details of the DNS resolver to create.
+//
+// Returns:
+// - dnsResolverCreateResp: The response from the NetworkingCreateDnsResolver API call, use EventTaskID with the body to monitor the create.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingDnsResolverCreate(ctx context.Context, hubID string, createDnsResolver models.NetworkingCreateDnsResolver) (*client.NetworkingCreateDnsResolverResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsResolverCreateResp, err := cl.client.NetworkingCreateDnsResolverWithResponse(ctx, hubID, createDnsResolver, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingCreateDnsResolver", dnsResolverCreateResp.HTTPResponse, dnsResolverCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsResolverCreateResp, nil
+}
+
+//	NetworkingDnsResolverReadByID reads a DNS resolver by ID from STAX.
+//
+// ctx: The context to use for this request.
+// resolverID: The ID of the DNS resolver to read.
+//
+// Returns:
+// - dnsResolverReadResp: The response from the NetworkingReadDnsResolver API call.
+// - err: A NotFoundError if the DNS resolver does not exist, or any other error that occurred.
+func (cl *Client) NetworkingDnsResolverReadByID(ctx context.Context, resolverID string) (*client.NetworkingReadDnsResolverResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsResolverReadResp, err := cl.client.NetworkingReadDnsResolverWithResponse(ctx, resolverID, &models.NetworkingReadDnsResolverParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadDnsResolver", dnsResolverReadResp.HTTPResponse, dnsResolverReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if dnsResolverReadResp.JSON200 == nil || len(dnsResolverReadResp.JSON200.DnsResolvers) != 1 {
+		return nil, &NotFoundError{Resource: "networking dns resolver", ID: resolverID}
+	}
+
+	return dnsResolverReadResp, nil
+}
+
+//	NetworkingDnsResolverUpdate updates a DNS resolver in STAX.
+//
+// ctx: The context to use for this request.
+// resolverID: The ID of the DNS resolver to update.
+// updateDnsResolver: The DNS resolver update parameters.
+//
+// Returns:
+// - dnsResolverUpdateResp: The response from the NetworkingUpdateDnsResolver API call, use EventTaskID with the body to monitor the update.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingDnsResolverUpdate(ctx context.Context, resolverID string, updateDnsResolver models.NetworkingUpdateDnsResolver) (*client.NetworkingUpdateDnsResolverResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsResolverUpdateResp, err := cl.client.NetworkingUpdateDnsResolverWithResponse(ctx, resolverID, updateDnsResolver, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingUpdateDnsResolver", dnsResolverUpdateResp.HTTPResponse, dnsResolverUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsResolverUpdateResp, nil
+}
+
+//	NetworkingDnsResolverDelete deletes a DNS resolver in STAX.
+//
+// ctx: The context to use for this request.
+// resolverID: The ID of the DNS resolver to delete.
+//
+// Returns:
+// - dnsResolverDeleteResp: The response from the NetworkingDeleteDnsResolver API call, use EventTaskID with the body to monitor the delete.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingDnsResolverDelete(ctx context.Context, resolverID string) (*client.NetworkingDeleteDnsResolverResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsResolverDeleteResp, err := cl.client.NetworkingDeleteDnsResolverWithResponse(ctx, resolverID, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingDeleteDnsResolver", dnsResolverDeleteResp.HTTPResponse, dnsResolverDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsResolverDeleteResp, nil
+}
+
+//	NetworkingDnsRuleCreate creates a new DNS rule in a DNS resolver in STAX.
+//
+// ctx: The context to use for this request.
+// resolverID: The ID of the DNS resolver the DNS rule belongs to.
+// createDnsRule: The details of the DNS rule to create.
+//
+// Returns:
+// - dnsRuleCreateResp: The response from the NetworkingCreateDnsRule API call, use EventTaskID with the body to monitor the create.
+// - err: Any error that occurred.
+func (cl *Client) NetworkingDnsRuleCreate(ctx context.Context, resolverID string, createDnsRule models.NetworkingCreateDnsRule) (*client.NetworkingCreateDnsRuleResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsRuleCreateResp, err := cl.client.NetworkingCreateDnsRuleWithResponse(ctx, resolverID, createDnsRule, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingCreateDnsRule", dnsRuleCreateResp.HTTPResponse, dnsRuleCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsRuleCreateResp, nil
+}
+
+//	NetworkingDnsRuleReadByID reads a DNS rule by ID from STAX.
+//
+// ctx: The context to use for this request.
+// ruleID: The ID of the DNS rule to read.
+//
+// Returns:
+// - dnsRuleReadResp: The response from the NetworkingReadDnsRule API call.
+// - err: A NotFoundError if the DNS rule does not exist, or any other error that occurred.
+func (cl *Client) NetworkingDnsRuleReadByID(ctx context.Context, ruleID string) (*client.NetworkingReadDnsRuleResp, error) {
+	err := cl.checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsRuleReadResp, err := cl.client.NetworkingReadDnsRuleWithResponse(ctx, ruleID, &models.NetworkingReadDnsRuleParams{}, cl.authRequestSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkResponse(ctx, "NetworkingReadDnsRule", dnsRuleReadResp.HTTPResponse, dnsRuleReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if dnsRuleReadResp.JSON200 == nil || len(dnsRuleReadResp.JSON200.DnsRules) != 1 {
+		return nil, &NotFoundError{Resource: "networking dns rule", ID: ruleID}
+	}
+
+	return dnsRuleReadResp, nil
+}
+
+//	NetworkingDnsRuleReadByResolver reads the active DNS rules of a DNS resolver from STAX.
 //
 // ctx: The context to use for this request.
-// accountID: The ID of the account to read.
+// resolverID: The ID of the DNS resolver.
 //
 // Returns:
-// - readAccountRes: The response from the AccountsReadAccount API call.
+// - dnsRulesReadResp: The response from the NetworkingReadResolverDnsRules API call.
 // - err: Any error that occurred.
-func (cl *Client) AccountReadByID(ctx context.Context, accountID string) (*client.AccountsReadAccountResp, error) {
+func (cl *Client) NetworkingDnsRuleReadByResolver(ctx context.Context, resolverID string) (*client.NetworkingReadResolverDnsRulesResp, error) {
 	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	readAccountRes, err := cl.client.AccountsReadAccountWithResponse(ctx, accountID, &models.AccountsReadAccountParams{}, cl.authRequestSigner)
+	dnsRulesReadResp, err := cl.client.NetworkingReadResolverDnsRulesWithResponse(ctx, resolverID, &models.NetworkingReadResolverDnsRulesParams{Status: aws.String("ACTIVE")}, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, readAccountRes, string(readAccountRes.Body))
+	err = checkResponse(ctx, "NetworkingReadResolverDnsRules", dnsRulesReadResp.HTTPResponse, dnsRulesReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	// FIXME: API can return a list with zero entries if the identifier doesn't exist
-	if len(readAccountRes.JSON200.Accounts) != 1 {
-		return nil, fmt.Errorf("account not found for identifier: %s", accountID)
-	}
-
-	return readAccountRes, nil
+	return dnsRulesReadResp, nil
 }
 
-//	AccountRead reads accounts from STAX.
+//	NetworkingDnsRuleUpdate updates a DNS rule in STAX.
 //
 // ctx: The context to use for this request.
-// accountIDs: Optional list of account IDs to filter by.
-// accountNames: Optional list of account names to filter by.
+// ruleID: The ID of the DNS rule to update.
+// updateDnsRule: The DNS rule update parameters.
 //
 // Returns:
-// - readAccountsRes: The response from the AccountsReadAccounts API call.
+// - dnsRuleUpdateResp: The response from the NetworkingUpdateDnsRule API call, use EventTaskID with the body to monitor the update.
 // - err: Any error that occurred.
-func (cl *Client) AccountRead(ctx context.Context, accountIDs []string, accountNames []string) (*client.AccountsReadAccountsResp, error) {
+func (cl *Client) NetworkingDnsRuleUpdate(ctx context.Context, ruleID string, updateDnsRule models.NetworkingUpdateDnsRule) (*client.NetworkingUpdateDnsRuleResp, error) {
 	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	idFilter := helpers.CommaDelimitedOptionalValue(accountIDs)
-	accountNamesFilter := helpers.CommaDelimitedOptionalValue(accountNames)
-
-	// TODO: implement paginated results
-	readAccountsRes, err := cl.client.AccountsReadAccountsWithResponse(ctx, &models.AccountsReadAccountsParams{
-		Filter:       aws.String(string(models.AccountStatusACTIVE)),
-		IncludeTags:  aws.Bool(true),
-		IdFilter:     idFilter,
-		AccountNames: accountNamesFilter,
-	}, cl.authRequestSigner)
+	dnsRuleUpdateResp, err := cl.client.NetworkingUpdateDnsRuleWithResponse(ctx, ruleID, updateDnsRule, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, readAccountsRes, string(readAccountsRes.Body))
+	err = checkResponse(ctx, "NetworkingUpdateDnsRule", dnsRuleUpdateResp.HTTPResponse, dnsRuleUpdateResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	return readAccountsRes, nil
+	return dnsRuleUpdateResp, nil
 }
 
-//	AccountUpdate updates an account in STAX.
+//	NetworkingDnsRuleDelete deletes a DNS rule in STAX.
 //
 // ctx: The context to use for this request.
-// accountID: The ID of the account to update.
-// updateAccount: The account update parameters.
+// ruleID: The ID of the DNS rule to delete.
 //
 // Returns:
-// - accountUpdateRes: The response from the AccountsUpdateAccount API call.
+// - dnsRuleDeleteResp: The response from the NetworkingDeleteDnsRule API call, use EventTaskID with the body to monitor the delete.
 // - err: Any error that occurred.
-func (cl *Client) AccountUpdate(ctx context.Context, accountID string, updateAccount models.AccountsUpdateAccount) (*client.AccountsUpdateAccountResp, error) {
+func (cl *Client) NetworkingDnsRuleDelete(ctx context.Context, ruleID string) (*client.NetworkingDeleteDnsRuleResp, error) {
 	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	accountUpdateRes, err := cl.client.AccountsUpdateAccountWithResponse(ctx, accountID, updateAccount, cl.authRequestSigner)
+	dnsRuleDeleteResp, err := cl.client.NetworkingDeleteDnsRuleWithResponse(ctx, ruleID, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, accountUpdateRes, string(accountUpdateRes.Body))
+	err = checkResponse(ctx, "NetworkingDeleteDnsRule", dnsRuleDeleteResp.HTTPResponse, dnsRuleDeleteResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	return accountUpdateRes, nil
+	return dnsRuleDeleteResp, nil
 }
 
-//	AccountClose closes an account in STAX.
+//	NetworkingVpnCustomerGatewayCreate creates a new VPN customer gateway in STAX.
 //
 // ctx: The context to use for this request.
-// accountID: The ID of the account to close.
+// createVpnCustomerGateway: The details of the VPN customer gateway to create.
 //
 // Returns:
-// - accountCloseResp: The response from the AccountsCloseAccount API call.
+// - customerGatewayCreateResp: The response from the NetworkingCreateVpnCustomerGateway API call, use EventTaskID with the body to monitor the create.
 // - err: Any error that occurred.
-func (cl *Client) AccountClose(ctx context.Context, accountID string) (*client.AccountsCloseAccountResp, error) {
+func (cl *Client) NetworkingVpnCustomerGatewayCreate(ctx context.Context, createVpnCustomerGateway models.NetworkingCreateVpnCustomerGateway) (*client.NetworkingCreateVpnCustomerGatewayResp, error) {
 	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	accountCloseResp, err := cl.client.AccountsCloseAccountWithResponse(ctx, models.AccountsCloseAccount{
-		Id: accountID,
-	}, cl.authRequestSigner)
+	customerGatewayCreateResp, err := cl.client.NetworkingCreateVpnCustomerGatewayWithResponse(ctx, createVpnCustomerGateway, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, accountCloseResp, string(accountCloseResp.Body))
+	err = checkResponse(ctx, "NetworkingCreateVpnCustomerGateway", customerGatewayCreateResp.HTTPResponse, customerGatewayCreateResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	return accountCloseResp, nil
+	return customerGatewayCreateResp, nil
 }
 
-//	AccountTypeReadById reads an account type by ID.
-//
-// ctx: The context for the request
-// accountTypeID: The ID of the account type to read
+//	NetworkingVpnCustomerGatewayReadByID reads a VPN customer gateway by ID from STAX.
 //
-// It returns:
+// ctx: The context to use for this request.
+// customerGatewayID: The ID of the VPN customer gateway to read.
 //
-// - *client.AccountsReadAccountTypeResp: The response containing the requested account type
-// - error: Any error that occurred while making the request.
-func (cl *Client) AccountTypeReadById(ctx context.Context, accountTypeID string) (*client.AccountsReadAccountTypeResp, error) {
+// Returns:
+// - customerGatewayReadResp: The response from the NetworkingReadVpnCustomerGateway API call.
+// - err: A NotFoundError if the VPN customer gateway does not exist, or any other error that occurred.
+func (cl *Client) NetworkingVpnCustomerGatewayReadByID(ctx context.Context, customerGatewayID string) (*client.NetworkingReadVpnCustomerGatewayResp, error) {
 	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	accountTypeResp, err := cl.client.AccountsReadAccountTypeWithResponse(ctx, accountTypeID, &models.AccountsReadAccountTypeParams{}, cl.authRequestSigner)
+	customerGatewayReadResp, err := cl.client.NetworkingReadVpnCustomerGatewayWithResponse(ctx, customerGatewayID, &models.NetworkingReadVpnCustomerGatewayParams{}, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, accountTypeResp, string(accountTypeResp.Body))
+	err = checkResponse(ctx, "NetworkingReadVpnCustomerGateway", customerGatewayReadResp.HTTPResponse, customerGatewayReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	// FIXME: API can return a list with zero entries if the identifier doesn't exist
-	if len(accountTypeResp.JSON200.AccountTypes) != 1 {
-		return nil, fmt.Errorf("account type not found for identifier: %s", accountTypeID)
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if customerGatewayReadResp.JSON200 == nil || len(customerGatewayReadResp.JSON200.VpnCustomerGateways) != 1 {
+		return nil, &NotFoundError{Resource: "networking vpn customer gateway", ID: customerGatewayID}
 	}
 
-	return accountTypeResp, nil
+	return customerGatewayReadResp, nil
 }
 
-//	AccountTypeRead reads account types from STAX.
+//	NetworkingVpnCustomerGatewayUpdate updates a VPN customer gateway in STAX.
 //
 // ctx: The context to use for this request.
-// accountTypeIDs: A list of account type IDs to filter the results. If empty, all account types will be returned.
+// customerGatewayID: The ID of the VPN customer gateway to update.
+// updateVpnCustomerGateway: The VPN customer gateway update parameters.
 //
 // Returns:
-// - accountTypesResp: The response from the AccountsReadAccountTypes API call.
+// - customerGatewayUpdateResp: The response from the NetworkingUpdateVpnCustomerGateway API call, use EventTaskID with the body to monitor the update.
 // - err: Any error that occurred.
-func (cl *Client) AccountTypeRead(ctx context.Context, accountTypeIDs []string) (*client.AccountsReadAccountTypesResp, error) {
+func (cl *Client) NetworkingVpnCustomerGatewayUpdate(ctx context.Context, customerGatewayID string, updateVpnCustomerGateway models.NetworkingUpdateVpnCustomerGateway) (*client.NetworkingUpdateVpnCustomerGatewayResp, error) {
 	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	accountTypesFilter := helpers.CommaDelimitedOptionalValue(accountTypeIDs)
-
-	// TODO: implement paginated results
-	accountTypesResp, err := cl.client.AccountsReadAccountTypesWithResponse(ctx, &models.AccountsReadAccountTypesParams{
-		IdFilter: accountTypesFilter,
-	}, cl.authRequestSigner)
+	customerGatewayUpdateResp, err := cl.client.NetworkingUpdateVpnCustomerGatewayWithResponse(ctx, customerGatewayID, updateVpnCustomerGateway, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, accountTypesResp, string(accountTypesResp.Body))
+	err = checkResponse(ctx, "NetworkingUpdateVpnCustomerGateway", customerGatewayUpdateResp.HTTPResponse, customerGatewayUpdateResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	return accountTypesResp, nil
+	return customerGatewayUpdateResp, nil
 }
 
-//	AccountTypeCreate creates an account type in STAX.
+//	NetworkingVpnCustomerGatewayDelete deletes a VPN customer gateway in STAX.
 //
 // ctx: The context to use for this request.
-// name: The name of the account type to create.
+// customerGatewayID: The ID of the VPN customer gateway to delete.
 //
 // Returns:
-// - accountTypeResp: The response from the AccountsCreateAccountType API call.
+// - customerGatewayDeleteResp: The response from the NetworkingDeleteVpnCustomerGateway API call, use EventTaskID with the body to monitor the delete.
 // - err: Any error that occurred.
-func (cl *Client) AccountTypeCreate(ctx context.Context, name string) (*client.AccountsCreateAccountTypeResp, error) {
-	accountTypeResp, err := cl.client.AccountsCreateAccountTypeWithResponse(ctx, models.AccountsCreateAccountType{
-		Name: name,
-	}, cl.authRequestSigner)
+func (cl *Client) NetworkingVpnCustomerGatewayDelete(ctx context.Context, customerGatewayID string) (*client.NetworkingDeleteVpnCustomerGatewayResp, error) {
+	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, accountTypeResp, string(accountTypeResp.Body))
+	customerGatewayDeleteResp, err := cl.client.NetworkingDeleteVpnCustomerGatewayWithResponse(ctx, customerGatewayID, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	return accountTypeResp, nil
+	err = checkResponse(ctx, "NetworkingDeleteVpnCustomerGateway", customerGatewayDeleteResp.HTTPResponse, customerGatewayDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return customerGatewayDeleteResp, nil
 }
 
-//	AccountTypeUpdate updates an account type in STAX.
+//	NetworkingVpnConnectionCreate creates a new VPN connection in a VPN customer gateway in STAX.
 //
 // ctx: The context to use for this request.
-// accountTypeID: The ID of the account type to update.
-// name: The new name of the account type.
+// customerGatewayID: The ID of the VPN customer gateway the VPN connection belongs to.
+// createVpnConnection: The details of the VPN connection to create.
 //
 // Returns:
-// - accountTypeUpdateResp: The response from the AccountsUpdateAccountType API call.
+// - vpnConnectionCreateResp: The response from the NetworkingCreateVpnConnection API call, use EventTaskID with the body to monitor the create.
 // - err: Any error that occurred.
-func (cl *Client) AccountTypeUpdate(ctx context.Context, accountTypeID, name string) (*client.AccountsUpdateAccountTypeResp, error) {
-	accountTypeUpdateResp, err := cl.client.AccountsUpdateAccountTypeWithResponse(ctx, accountTypeID, models.AccountsUpdateAccountType{
-		Name: name,
-	}, cl.authRequestSigner)
+func (cl *Client) NetworkingVpnConnectionCreate(ctx context.Context, customerGatewayID string, createVpnConnection models.NetworkingCreateVpnConnection) (*client.NetworkingCreateVpnConnectionResp, error) {
+	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, accountTypeUpdateResp, string(accountTypeUpdateResp.Body))
+	vpnConnectionCreateResp, err := cl.client.NetworkingCreateVpnConnectionWithResponse(ctx, customerGatewayID, createVpnConnection, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	return accountTypeUpdateResp, nil
+	err = checkResponse(ctx, "NetworkingCreateVpnConnection", vpnConnectionCreateResp.HTTPResponse, vpnConnectionCreateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpnConnectionCreateResp, nil
 }
 
-//	AccountTypeDelete deletes an account type in STAX.
+//	NetworkingVpnConnectionReadByID reads a VPN connection by ID from STAX.
 //
 // ctx: The context to use for this request.
-// accountTypeID: The ID of the account type to delete.
+// connectionID: The ID of the VPN connection to read.
 //
 // Returns:
-// - accountTypeDeleteResp: The response from the AccountsDeleteAccountType API call.
-// - err: Any error that occurred.
-func (cl *Client) AccountTypeDelete(ctx context.Context, accountTypeID string) (*client.AccountsDeleteAccountTypeResp, error) {
-	accountTypeDeleteResp, err := cl.client.AccountsDeleteAccountTypeWithResponse(ctx, accountTypeID, cl.authRequestSigner)
+// - vpnConnectionReadResp: The response from the NetworkingReadVpnConnection API call.
+// - err: A NotFoundError if the VPN connection does not exist, or any other error that occurred.
+func (cl *Client) NetworkingVpnConnectionReadByID(ctx context.Context, connectionID string) (*client.NetworkingReadVpnConnectionResp, error) {
+	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, accountTypeDeleteResp, string(accountTypeDeleteResp.Body))
+	vpnConnectionReadResp, err := cl.client.NetworkingReadVpnConnectionWithResponse(ctx, connectionID, &models.NetworkingReadVpnConnectionParams{}, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	return accountTypeDeleteResp, nil
+	err = checkResponse(ctx, "NetworkingReadVpnConnection", vpnConnectionReadResp.HTTPResponse, vpnConnectionReadResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns a list with zero entries if the identifier doesn't exist
+	if vpnConnectionReadResp.JSON200 == nil || len(vpnConnectionReadResp.JSON200.VpnConnections) != 1 {
+		return nil, &NotFoundError{Resource: "networking vpn connection", ID: connectionID}
+	}
+
+	return vpnConnectionReadResp, nil
 }
 
-//	WorkloadCreate creates a new workload in STAX.
+//	NetworkingVpnConnectionReadStatus reads the status of the tunnels of a VPN connection from STAX.
 //
 // ctx: The context to use for this request.
-// createWorkload: The details of the workload to create.
+// connectionID: The ID of the VPN connection.
 //
 // Returns:
-// - workloadCreateResp: The response from the WorkloadsCreateWorkload API call.
+// - vpnConnectionStatusResp: The response from the NetworkingReadVpnConnectionStatus API call, containing the status of each tunnel.
 // - err: Any error that occurred.
-func (cl *Client) WorkloadCreate(ctx context.Context, createWorkload models.WorkloadsCreateWorkload) (*client.WorkloadsCreateWorkloadResp, error) {
-	workloadCreateResp, err := cl.client.WorkloadsCreateWorkloadWithResponse(ctx, createWorkload, cl.authRequestSigner)
+func (cl *Client) NetworkingVpnConnectionReadStatus(ctx context.Context, connectionID string) (*client.NetworkingReadVpnConnectionStatusResp, error) {
+	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, workloadCreateResp, string(workloadCreateResp.Body))
+	vpnConnectionStatusResp, err := cl.client.NetworkingReadVpnConnectionStatusWithResponse(ctx, connectionID, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	return workloadCreateResp, nil
+	err = checkResponse(ctx, "NetworkingReadVpnConnectionStatus", vpnConnectionStatusResp.HTTPResponse, vpnConnectionStatusResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpnConnectionStatusResp, nil
 }
 
-//	WorkloadRead reads workloads from STAX.
+//	NetworkingVpnConnectionUpdate updates a VPN connection in STAX.
 //
 // ctx: The context to use for this request.
-// params: The parameters for filtering which workloads to read.
+// connectionID: The ID of the VPN connection to update.
+// updateVpnConnection: The VPN connection update parameters.
 //
 // Returns:
-// - workloadsReadResp: The response from the WorkloadsReadWorkloads API call.
+// - vpnConnectionUpdateResp: The response from the NetworkingUpdateVpnConnection API call, use EventTaskID with the body to monitor the update.
 // - err: Any error that occurred.
-func (cl *Client) WorkloadRead(ctx context.Context, params *models.WorkloadsReadWorkloadsParams) (*client.WorkloadsReadWorkloadsResp, error) {
-	workloadsReadResp, err := cl.client.WorkloadsReadWorkloadsWithResponse(ctx, params, cl.authRequestSigner)
+func (cl *Client) NetworkingVpnConnectionUpdate(ctx context.Context, connectionID string, updateVpnConnection models.NetworkingUpdateVpnConnection) (*client.NetworkingUpdateVpnConnectionResp, error) {
+	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, workloadsReadResp, string(workloadsReadResp.Body))
+	vpnConnectionUpdateResp, err := cl.client.NetworkingUpdateVpnConnectionWithResponse(ctx, connectionID, updateVpnConnection, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	return workloadsReadResp, nil
+	err = checkResponse(ctx, "NetworkingUpdateVpnConnection", vpnConnectionUpdateResp.HTTPResponse, vpnConnectionUpdateResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpnConnectionUpdateResp, nil
 }
 
-//	WorkloadDelete deletes a workload in STAX.
+//	NetworkingVpnConnectionDelete deletes a VPN connection in STAX.
 //
 // ctx: The context to use for this request.
-// workloadID: The ID of the workload to delete.
+// connectionID: The ID of the VPN connection to delete.
 //
 // Returns:
-// - workloadDeleteResp: The response from the WorkloadsDeleteWorkload API call.
+// - vpnConnectionDeleteResp: The response from the NetworkingDeleteVpnConnection API call, use EventTaskID with the body to monitor the delete.
 // - err: Any error that occurred.
-func (cl *Client) WorkloadDelete(ctx context.Context, workloadID string) (*client.WorkloadsDeleteWorkloadResp, error) {
-	workloadDeleteResp, err := cl.client.WorkloadsDeleteWorkloadWithResponse(ctx, workloadID, cl.authRequestSigner)
+func (cl *Client) NetworkingVpnConnectionDelete(ctx context.Context, connectionID string) (*client.NetworkingDeleteVpnConnectionResp, error) {
+	err := cl.checkSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = checkResponse(ctx, workloadDeleteResp, string(workloadDeleteResp.Body))
+	vpnConnectionDeleteResp, err := cl.client.NetworkingDeleteVpnConnectionWithResponse(ctx, connectionID, cl.authRequestSigner)
 	if err != nil {
 		return nil, err
 	}
 
-	return workloadDeleteResp, nil
+	err = checkResponse(ctx, "NetworkingDeleteVpnConnection", vpnConnectionDeleteResp.HTTPResponse, vpnConnectionDeleteResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpnConnectionDeleteResp, nil
 }
 
 func (cl *Client) UserReadByID(ctx context.Context, userID string) (*client.TeamsReadUserResp, error) {
@@ -659,15 +2483,15 @@ func (cl *Client) UserReadByID(ctx context.Context, userID string) (*client.Team
 		return nil, err
 	}
 
-	if userReadResp.StatusCode() == 404 {
-		return nil, fmt.Errorf("user not found for identifier: %s", userID)
-	}
-
-	err = checkResponse(ctx, userReadResp, string(userReadResp.Body))
+	err = checkResponse(ctx, "TeamsReadUser", userReadResp.HTTPResponse, userReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(userReadResp.JSON200.Users) == 0 {
+		return nil, &NotFoundError{Resource: "user", ID: userID}
+	}
+
 	return userReadResp, nil
 }
 
@@ -679,7 +2503,7 @@ func (cl *Client) UserRead(ctx context.Context, userIDs []string) (*client.Teams
 		return nil, err
 	}
 
-	err = checkResponse(ctx, usersReadResp, string(usersReadResp.Body))
+	err = checkResponse(ctx, "TeamsReadUsers", usersReadResp.HTTPResponse, usersReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -693,7 +2517,7 @@ func (cl *Client) UserCreate(ctx context.Context, params models.TeamsCreateUser)
 		return nil, err
 	}
 
-	err = checkResponse(ctx, createUserResp, string(createUserResp.Body))
+	err = checkResponse(ctx, "TeamsCreateUser", createUserResp.HTTPResponse, createUserResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -707,7 +2531,7 @@ func (cl *Client) UserUpdate(ctx context.Context, userID string, params models.T
 		return nil, err
 	}
 
-	err = checkResponse(ctx, updateUserResp, string(updateUserResp.Body))
+	err = checkResponse(ctx, "TeamsUpdateUser", updateUserResp.HTTPResponse, updateUserResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -721,7 +2545,7 @@ func (cl *Client) UserDelete(ctx context.Context, userID string) (*client.TeamsD
 		return nil, err
 	}
 
-	err = checkResponse(ctx, deleteUserResp, string(deleteUserResp.Body))
+	err = checkResponse(ctx, "TeamsDeleteUser", deleteUserResp.HTTPResponse, deleteUserResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -735,15 +2559,15 @@ func (cl *Client) APITokenReadByID(ctx context.Context, apiTokenID string) (*cli
 		return nil, err
 	}
 
-	if userReadResp.StatusCode() == 404 {
-		return nil, fmt.Errorf("api token not found for identifier: %s", apiTokenID)
-	}
-
-	err = checkResponse(ctx, userReadResp, string(userReadResp.Body))
+	err = checkResponse(ctx, "TeamsReadApiToken", userReadResp.HTTPResponse, userReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(userReadResp.JSON200.ApiTokens) == 0 {
+		return nil, &NotFoundError{Resource: "api token", ID: apiTokenID}
+	}
+
 	return userReadResp, nil
 }
 
@@ -755,7 +2579,7 @@ func (cl *Client) APITokenRead(ctx context.Context, apiTokenIDs []string) (*clie
 		return nil, err
 	}
 
-	err = checkResponse(ctx, userReadResp, string(userReadResp.Body))
+	err = checkResponse(ctx, "TeamsReadApiTokens", userReadResp.HTTPResponse, userReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -769,7 +2593,7 @@ func (cl *Client) APITokenCreate(ctx context.Context, params models.TeamsCreateA
 		return nil, err
 	}
 
-	err = checkResponse(ctx, createUserResp, string(createUserResp.Body))
+	err = checkResponse(ctx, "TeamsCreateApiToken", createUserResp.HTTPResponse, createUserResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -783,7 +2607,7 @@ func (cl *Client) ApiTokenUpdate(ctx context.Context, apiTokenID string, params
 		return nil, err
 	}
 
-	err = checkResponse(ctx, updateUserResp, string(updateUserResp.Body))
+	err = checkResponse(ctx, "TeamsUpdateApiToken", updateUserResp.HTTPResponse, updateUserResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -797,7 +2621,7 @@ func (cl *Client) ApiTokenDelete(ctx context.Context, apiTokenID string) (*clien
 		return nil, err
 	}
 
-	err = checkResponse(ctx, deleteUserResp, string(deleteUserResp.Body))
+	err = checkResponse(ctx, "TeamsDeleteApiToken", deleteUserResp.HTTPResponse, deleteUserResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -819,15 +2643,15 @@ func (cl *Client) GroupReadByID(ctx context.Context, groupID string) (*client.Te
 		return nil, err
 	}
 
-	if groupReadResp.StatusCode() == 404 {
-		return nil, fmt.Errorf("group not found for identifier: %s", groupID)
-	}
-
-	err = checkResponse(ctx, groupReadResp, string(groupReadResp.Body))
+	err = checkResponse(ctx, "TeamsReadGroup", groupReadResp.HTTPResponse, groupReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(groupReadResp.JSON200.Groups) == 0 {
+		return nil, &NotFoundError{Resource: "group", ID: groupID}
+	}
+
 	return groupReadResp, nil
 }
 
@@ -850,7 +2674,7 @@ func (cl *Client) GroupRead(ctx context.Context, groupIDs []string) (*client.Tea
 		return nil, err
 	}
 
-	err = checkResponse(ctx, teamsReadResp, string(teamsReadResp.Body))
+	err = checkResponse(ctx, "TeamsReadGroups", teamsReadResp.HTTPResponse, teamsReadResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -869,14 +2693,12 @@ func (cl *Client) GroupAssignUsers(ctx context.Context, groupID string, addMembe
 		return nil, err
 	}
 
-	switch updateMembersResp.StatusCode() {
-	case http.StatusOK:
-		return updateMembersResp, nil
-	case http.StatusBadRequest:
-		return nil, fmt.Errorf("request failed, returned non 200 status: %s error: %s", updateMembersResp.Status(), aws.ToString(updateMembersResp.JSON400.Error))
+	err = checkResponse(ctx, "TeamsUpdateGroupMembers", updateMembersResp.HTTPResponse, updateMembersResp.Body)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("request failed, returned non 200 status: %s", updateMembersResp.Status())
+	return updateMembersResp, nil
 }
 
 func userGroupMemberMap(groupID string, userIDs []string) *[]models.UserGroupMemberMap {
@@ -908,7 +2730,7 @@ func (cl *Client) GroupCreate(ctx context.Context, name string) (*client.TeamsCr
 		return nil, err
 	}
 
-	err = checkResponse(ctx, createGroupResp, string(createGroupResp.Body))
+	err = checkResponse(ctx, "TeamsCreateGroup", createGroupResp.HTTPResponse, createGroupResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -924,7 +2746,7 @@ func (cl *Client) GroupUpdate(ctx context.Context, groupID, name string) (*clien
 		return nil, err
 	}
 
-	err = checkResponse(ctx, updateGroupResp, string(updateGroupResp.Body))
+	err = checkResponse(ctx, "TeamsUpdateGroup", updateGroupResp.HTTPResponse, updateGroupResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -938,7 +2760,7 @@ func (cl *Client) GroupDelete(ctx context.Context, groupID string) (*client.Team
 		return nil, err
 	}
 
-	err = checkResponse(ctx, deleteGroupResp, string(deleteGroupResp.Body))
+	err = checkResponse(ctx, "TeamsDeleteGroup", deleteGroupResp.HTTPResponse, deleteGroupResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -958,7 +2780,7 @@ func (cl *Client) PermissionSetsReadByID(ctx context.Context, permissionSetId st
 		return nil, err
 	}
 
-	err = checkResponse(ctx, readResp, string(readResp.Body))
+	err = checkResponse(ctx, "GetPermissionSet", readResp.HTTPResponse, readResp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -966,17 +2788,45 @@ func (cl *Client) PermissionSetsReadByID(ctx context.Context, permissionSetId st
 	return readResp, nil
 }
 
+// PermissionSetsList lists permission sets, walking all pages of results. Any PageToken in params is ignored.
 func (cl *Client) PermissionSetsList(ctx context.Context, params *permissionssetsmodels.ListPermissionSetsParams) (*permissionssetsclient.ListPermissionSetsResponse, error) {
-	listResp, err := cl.permissionSetsClient.ListPermissionSetsWithResponse(ctx, params, cl.authRequestSigner)
-	if err != nil {
-		return nil, err
+	pageParams := permissionssetsmodels.ListPermissionSetsParams{}
+	if params != nil {
+		pageParams = *params
 	}
 
-	err = checkResponse(ctx, listResp, string(listResp.Body))
+	var listResp *permissionssetsclient.ListPermissionSetsResponse
+
+	pager := NewTokenPager(func(ctx context.Context, pageToken *string) ([]permissionssetsmodels.PermissionSetRecord, *string, error) {
+		pageParams.PageToken = pageToken
+
+		var err error
+
+		listResp, err = cl.permissionSetsClient.ListPermissionSetsWithResponse(ctx, &pageParams, cl.authRequestSigner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = checkResponse(ctx, "ListPermissionSets", listResp.HTTPResponse, listResp.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if listResp.JSON200 == nil {
+			return nil, nil, nil
+		}
+
+		return listResp.JSON200.PermissionSets, nextPageToken(listResp.JSON200.Paging), nil
+	})
+
+	permissionSets, err := CollectPages(ctx, pager)
 	if err != nil {
 		return nil, err
 	}
 
+	// the last page may not have a body, so the permission sets collected from every page are returned regardless
+	listResp.JSON200 = &permissionssetsmodels.ListPermissionSets{PermissionSets: permissionSets}
+
 	return listResp, nil
 }
 
@@ -986,14 +2836,12 @@ func (cl *Client) PermissionSetsCreate(ctx context.Context, params permissionsse
 		return nil, err
 	}
 
-	switch createResp.StatusCode() {
-	case http.StatusCreated:
-		return createResp, nil
-	case http.StatusBadRequest:
-		return nil, fmt.Errorf("request failed, returned non 201 status: %s, code: %d, message: %s", createResp.Status(), createResp.JSON400.Error.Code, createResp.JSON400.Error.Message)
+	err = checkResponseStatus(ctx, "CreatePermissionSet", createResp.HTTPResponse, createResp.Body, http.StatusCreated)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("request failed, returned non 201 status: %s", createResp.Status())
+	return createResp, nil
 }
 
 func (cl *Client) PermissionSetsUpdate(ctx context.Context, permissionSetId string, params permissionssetsmodels.UpdatePermissionSetRecord) (*permissionssetsclient.UpdatePermissionSetResponse, error) {
@@ -1007,9 +2855,9 @@ func (cl *Client) PermissionSetsUpdate(ctx context.Context, permissionSetId stri
 		return nil, err
 	}
 
-	if updateResp.StatusCode() != http.StatusOK {
-		// TODO: split out each of the error types by status code
-		return nil, fmt.Errorf("request failed, returned non 200 status: %s", updateResp.Status())
+	err = checkResponse(ctx, "UpdatePermissionSet", updateResp.HTTPResponse, updateResp.Body)
+	if err != nil {
+		return nil, err
 	}
 
 	return updateResp, nil
@@ -1026,14 +2874,15 @@ func (cl *Client) PermissionSetsDelete(ctx context.Context, permissionSetId stri
 		return nil, err
 	}
 
-	if deleteResp.StatusCode() != http.StatusOK {
-		// TODO: split out each of the error types by status code
-		return nil, fmt.Errorf("request failed, returned non 200 status: %s", deleteResp.Status())
+	err = checkResponse(ctx, "DeletePermissionSet", deleteResp.HTTPResponse, deleteResp.Body)
+	if err != nil {
+		return nil, err
 	}
 
 	return deleteResp, nil
 }
 
+// PermissionSetAssignmentList lists the assignments of a permission set, walking all pages of results. Any PageToken in params is ignored.
 func (cl *Client) PermissionSetAssignmentList(ctx context.Context, permissionSetId string, params *permissionssetsmodels.ListPermissionSetAssignmentsParams) (*permissionssetsclient.ListPermissionSetAssignmentsResponse, error) {
 
 	psetId, err := uuid.Parse(permissionSetId)
@@ -1041,16 +2890,47 @@ func (cl *Client) PermissionSetAssignmentList(ctx context.Context, permissionSet
 		return nil, fmt.Errorf("failed to parse permission set id: %w", err)
 	}
 
-	readResp, err := cl.permissionSetsClient.ListPermissionSetAssignmentsWithResponse(ctx, psetId, params, cl.authRequestSigner)
-	if err != nil {
-		return nil, err
+	return cl.listPermissionSetAssignments(ctx, psetId, params)
+}
+
+func (cl *Client) listPermissionSetAssignments(ctx context.Context, psetId uuid.UUID, params *permissionssetsmodels.ListPermissionSetAssignmentsParams) (*permissionssetsclient.ListPermissionSetAssignmentsResponse, error) {
+	pageParams := permissionssetsmodels.ListPermissionSetAssignmentsParams{}
+	if params != nil {
+		pageParams = *params
 	}
 
-	err = checkResponse(ctx, readResp, string(readResp.Body))
+	var readResp *permissionssetsclient.ListPermissionSetAssignmentsResponse
+
+	pager := NewTokenPager(func(ctx context.Context, pageToken *string) ([]permissionssetsmodels.AssignmentRecord, *string, error) {
+		pageParams.PageToken = pageToken
+
+		var err error
+
+		readResp, err = cl.permissionSetsClient.ListPermissionSetAssignmentsWithResponse(ctx, psetId, &pageParams, cl.authRequestSigner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = checkResponse(ctx, "ListPermissionSetAssignments", readResp.HTTPResponse, readResp.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if readResp.JSON200 == nil {
+			return nil, nil, nil
+		}
+
+		return readResp.JSON200.Assignments, nextPageToken(readResp.JSON200.Paging), nil
+	})
+
+	assignments, err := CollectPages(ctx, pager)
 	if err != nil {
 		return nil, err
 	}
 
+	// the last page may not have a body, so the assignments collected from every page are returned regardless
+	readResp.JSON200 = &permissionssetsmodels.ListAssignmentRecords{Assignments: assignments}
+
 	return readResp, nil
 }
 
@@ -1066,9 +2946,9 @@ func (cl *Client) PermissionSetAssignmentCreate(ctx context.Context, permissionS
 		return nil, err
 	}
 
-	if readResp.StatusCode() != http.StatusOK {
-		// TODO: split out each of the error types by status code
-		return nil, fmt.Errorf("request failed, returned non 200 status: %s", readResp.Status())
+	err = checkResponse(ctx, "CreatePermissionSetAssignments", readResp.HTTPResponse, readResp.Body)
+	if err != nil {
+		return nil, err
 	}
 
 	return readResp, nil
@@ -1090,19 +2970,39 @@ func (cl *Client) PermissionSetAssignmentDelete(ctx context.Context, permissionS
 		return nil, err
 	}
 
-	if deleteResp.StatusCode() != http.StatusOK {
-		// TODO: split out each of the error types by status code
-		return nil, fmt.Errorf("request failed, returned non 200 status: %s", deleteResp.Status())
+	err = checkResponse(ctx, "DeletePermissionSetAssignment", deleteResp.HTTPResponse, deleteResp.Body)
+	if err != nil {
+		return nil, err
 	}
 
 	return deleteResp, nil
 }
 
+//	TaskPollTimeout returns the overall deadline for asynchronous tasks, zero if tasks are waited on indefinitely.
+//
+// This is the timeout of the poller configured using WithTaskPollerConfig.
+func (cl *Client) TaskPollTimeout() time.Duration {
+	return cl.pollerConfig.Timeout
+}
+
+// taskPollerConfig returns the poller configuration used to wait for a task, a deadline on the context replaces the
+// poller deadline so callers can wait for longer, or shorter, than the configured timeout.
+func (cl *Client) taskPollerConfig(ctx context.Context) helpers.PollerConfig {
+	pollerConfig := cl.pollerConfig
+
+	if _, ok := ctx.Deadline(); ok {
+		pollerConfig.Timeout = 0
+	}
+
+	return pollerConfig
+}
+
 //	MonitorTask polls an asynchronous task and returns the final task response.
 //
 // It uses a TaskPoller to poll the TasksReadTask API endpoint for the status of the task.
-// It will continue polling until the task completes (succeeds or fails) or a timeout occurs.
-// If the task fails or times out, an error is returned.
+// It will continue polling until the task completes (succeeds or fails), the context is cancelled or the poller deadline is reached.
+// If the deadline is reached an error matching helpers.ErrTaskTimeout is returned, including the last observed status.
+// A deadline on the context replaces the poller deadline, in which case the context error is returned once it elapses.
 // Otherwise, the final client.TasksReadTaskResp is returned.
 // taskID is the ID of the asynchronous task to monitor.
 // callbackFunc is a function that will be called after each poll to determine whether polling should continue.
@@ -1117,11 +3017,16 @@ func (cl *Client) MonitorTask(ctx context.Context, taskID string, callbackFunc f
 		return nil, ErrMissingTaskCallbackFunc
 	}
 
-	tp := helpers.NewTaskPoller(func() (*client.TasksReadTaskResp, error) {
-		return cl.client.TasksReadTaskWithResponse(ctx, taskID, cl.authRequestSigner)
-	})
+	tp := helpers.NewTaskPollerWithConfig(func() (*client.TasksReadTaskResp, error) {
+		taskResp, err := cl.client.TasksReadTaskWithResponse(ctx, taskID, cl.authRequestSigner)
+		if err != nil {
+			return nil, err
+		}
 
-	// loop for until deadline or
+		return taskResp, checkResponse(ctx, "TasksReadTask", taskResp.HTTPResponse, taskResp.Body)
+	}, taskStatus, cl.taskPollerConfig(ctx))
+
+	// poll until the task completes, the deadline is reached or the context is cancelled
 	for tp.Poll(ctx) {
 
 		// the task poller checks the request success/failure so this result is always 200 OK
@@ -1135,8 +3040,6 @@ func (cl *Client) MonitorTask(ctx context.Context, taskID string, callbackFunc f
 		if isTaskComplete(taskRes.JSON200.Status) {
 			break
 		}
-
-		time.Sleep(10 * time.Second) // TODO: check some timeout
 	}
 
 	if err := tp.Err(); err != nil {
@@ -1161,11 +3064,11 @@ func (cl *Client) MonitorPermissionSetAssignments(ctx context.Context, permissio
 		return nil, fmt.Errorf("failed to parse permission set id: %w", err)
 	}
 
-	tp := helpers.NewTaskPoller(func() (*permissionssetsclient.ListPermissionSetAssignmentsResponse, error) {
-		return cl.permissionSetsClient.ListPermissionSetAssignmentsWithResponse(ctx, psetId, params, cl.authRequestSigner)
-	})
+	tp := helpers.NewTaskPollerWithConfig(func() (*permissionssetsclient.ListPermissionSetAssignmentsResponse, error) {
+		return cl.listPermissionSetAssignments(ctx, psetId, params)
+	}, assignmentStatus(assignmentID), cl.taskPollerConfig(ctx))
 
-	// loop for until deadline or
+	// poll until the task completes, the deadline is reached or the context is cancelled
 	for tp.Poll(ctx) {
 
 		// the task poller checks the request success/failure so this result is always 200 OK
@@ -1179,8 +3082,6 @@ func (cl *Client) MonitorPermissionSetAssignments(ctx context.Context, permissio
 		if isAssignmentComplete(assignmentID, completionStatuses, taskRes.JSON200.Assignments) {
 			break
 		}
-
-		time.Sleep(10 * time.Second) // TODO: check some timeout
 	}
 
 	if err := tp.Err(); err != nil {
@@ -1201,6 +3102,105 @@ func isAssignmentComplete(assignmentID string, completionStatuses []permissionss
 	return false
 }
 
+func assignmentStatus(assignmentID string) func(*permissionssetsclient.ListPermissionSetAssignmentsResponse) string {
+	return func(listResp *permissionssetsclient.ListPermissionSetAssignmentsResponse) string {
+		if listResp == nil || listResp.JSON200 == nil {
+			return ""
+		}
+
+		for _, assignment := range listResp.JSON200.Assignments {
+			if assignment.Id.String() == assignmentID {
+				return string(assignment.Status)
+			}
+		}
+
+		return ""
+	}
+}
+
+//	MonitorVpnConnectionTunnels polls the tunnel status of a VPN connection until every tunnel reports up.
+//
+// It uses a TaskPoller to poll the NetworkingReadVpnConnectionStatus API endpoint, with the client polling backoff and
+// the provided timeout in place of the task deadline, as tunnels only come up once the customer side is configured.
+// If the timeout is reached an error matching helpers.ErrTaskTimeout is returned, including the last observed tunnel status.
+// connectionID is the ID of the VPN connection to monitor.
+// callbackFunc is a function that will be called after each poll to determine whether polling should continue.
+func (cl *Client) MonitorVpnConnectionTunnels(ctx context.Context, connectionID string, timeout time.Duration, callbackFunc func(context.Context, *client.NetworkingReadVpnConnectionStatusResp) bool) (*client.NetworkingReadVpnConnectionStatusResp, error) {
+	if connectionID == "" {
+		return nil, errors.New("missing connectionID")
+	}
+
+	// callback function used to report interim status events
+	if callbackFunc == nil {
+		return nil, errors.New("missing vpn connection monitoring callback function")
+	}
+
+	pollerConfig := cl.pollerConfig
+	pollerConfig.Timeout = timeout
+
+	tp := helpers.NewTaskPollerWithConfig(func() (*client.NetworkingReadVpnConnectionStatusResp, error) {
+		return cl.NetworkingVpnConnectionReadStatus(ctx, connectionID)
+	}, vpnTunnelStatus, pollerConfig)
+
+	// poll until the tunnels are up, the deadline is reached or the context is cancelled
+	for tp.Poll(ctx) {
+
+		// the task poller checks the request success/failure so this result is always 200 OK
+		statusRes := tp.Resp()
+
+		// check whether it is OK to continue polling
+		if ok := callbackFunc(ctx, statusRes); !ok {
+			break
+		}
+
+		if AreVpnTunnelsUp(statusRes.JSON200) {
+			break
+		}
+	}
+
+	if err := tp.Err(); err != nil {
+		return nil, fmt.Errorf("vpn tunnels not up: %w", err)
+	}
+
+	return tp.Resp(), nil
+}
+
+// AreVpnTunnelsUp returns true when the VPN connection has tunnels and all of them report up.
+func AreVpnTunnelsUp(status *models.NetworkingReadVpnConnectionStatus) bool {
+	if status == nil || status.ConnectionStatus == nil || len(*status.ConnectionStatus) == 0 {
+		return false
+	}
+
+	for _, tunnel := range *status.ConnectionStatus {
+		if tunnel.TunnelStatus == nil || *tunnel.TunnelStatus != models.Up {
+			return false
+		}
+	}
+
+	return true
+}
+
+func vpnTunnelStatus(statusResp *client.NetworkingReadVpnConnectionStatusResp) string {
+	if statusResp == nil || statusResp.JSON200 == nil || statusResp.JSON200.ConnectionStatus == nil {
+		return ""
+	}
+
+	tunnels := make([]string, 0, len(*statusResp.JSON200.ConnectionStatus))
+	for _, tunnel := range *statusResp.JSON200.ConnectionStatus {
+		tunnels = append(tunnels, fmt.Sprintf("%s=%s", aws.ToString(tunnel.TunnelName), aws.ToString((*string)(tunnel.TunnelStatus))))
+	}
+
+	return strings.Join(tunnels, ", ")
+}
+
+func taskStatus(taskResp *client.TasksReadTaskResp) string {
+	if taskResp == nil || taskResp.JSON200 == nil {
+		return ""
+	}
+
+	return string(taskResp.JSON200.Status)
+}
+
 func isTaskComplete(status models.OperationStatus) bool {
 	return status == TaskFailed || status == TaskSucceeded
 }
@@ -1213,12 +3213,18 @@ func (cl *Client) checkSession(_ context.Context) error {
 	return nil
 }
 
-func checkResponse(_ context.Context, res helpers.HTTPResponse, _ string) error {
-	if res.StatusCode() != http.StatusOK {
-		return fmt.Errorf("request failed, returned non 200 status: %s", res.Status())
+// checkResponse returns an APIError if the response status is not 200 OK.
+func checkResponse(ctx context.Context, operation string, res *http.Response, body []byte) error {
+	return checkResponseStatus(ctx, operation, res, body, http.StatusOK)
+}
+
+// checkResponseStatus returns an APIError if the response status does not match the expected status code.
+func checkResponseStatus(_ context.Context, operation string, res *http.Response, body []byte, expectedStatusCode int) error {
+	if res != nil && res.StatusCode == expectedStatusCode {
+		return nil
 	}
 
-	return nil
+	return newAPIError(operation, res, body)
 }
 
 type installationURLs struct {
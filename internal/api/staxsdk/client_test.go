@@ -2,14 +2,17 @@ package staxsdk
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/cognito"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
@@ -151,6 +154,478 @@ func TestClient_AccountTypeReadById(t *testing.T) {
 	assert.Equal(accountTypes, accountTypeResp.JSON200)
 }
 
+func TestClient_AccountTypeUpdatePolicies(t *testing.T) {
+	assert := require.New(t)
+	accountTypeID := "3b7f3e2a-52a4-4b1b-9d0b-1c5f6ad3c0e2"
+	addPolicyID := "6e7c1f9a-0d3b-4c8e-9f2a-5b4d3c2a1e0f"
+	removePolicyID := "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	testClient, clientWithResponsesMock := NewTestClient(t)
+
+	clientWithResponsesMock.On("AccountsUpdateAccountTypePoliciesWithResponse",
+		mock.Anything,
+		models.AccountsUpdateAccountTypePolicies{
+			AddPolicies: &[]models.AccountTypePolicyMap{
+				{AccountTypeId: &accountTypeID, PolicyId: &addPolicyID},
+			},
+			RemovePolicies: &[]models.AccountTypePolicyMap{
+				{AccountTypeId: &accountTypeID, PolicyId: &removePolicyID},
+			},
+		},
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.AccountsUpdateAccountTypePoliciesResp{
+		JSON200:      &models.AccountsUpdateAccountTypePoliciesEvent{},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil)
+
+	updateResp, err := testClient.AccountTypeUpdatePolicies(context.TODO(), accountTypeID, []string{addPolicyID}, []string{removePolicyID})
+	assert.NoError(err)
+	assert.Equal(&models.AccountsUpdateAccountTypePoliciesEvent{}, updateResp.JSON200)
+}
+
+func TestClient_WorkloadReadByID(t *testing.T) {
+	workloadID := "0d3c4f64-5b2e-4e57-9a8f-3bd2f2f0d1a2"
+
+	t.Run("returns the workload", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+
+		workloads := &models.WorkloadsReadWorkloadsResponse{
+			Workloads: []models.Workload{
+				{Id: &workloadID, Name: "my-workload"},
+			},
+		}
+
+		clientWithResponsesMock.On("WorkloadsReadWorkloadWithResponse",
+			mock.Anything,
+			workloadID,
+			mock.AnythingOfType("*models.WorkloadsReadWorkloadParams"),
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(&client.WorkloadsReadWorkloadResp{
+			JSON200:      workloads,
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}, nil)
+
+		workloadResp, err := testClient.WorkloadReadByID(context.TODO(), workloadID)
+		assert.NoError(err)
+		assert.Equal(workloads, workloadResp.JSON200)
+	})
+
+	t.Run("returns a not found error for an empty list", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+
+		clientWithResponsesMock.On("WorkloadsReadWorkloadWithResponse",
+			mock.Anything,
+			workloadID,
+			mock.AnythingOfType("*models.WorkloadsReadWorkloadParams"),
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(&client.WorkloadsReadWorkloadResp{
+			JSON200:      &models.WorkloadsReadWorkloadsResponse{},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}, nil)
+
+		_, err := testClient.WorkloadReadByID(context.TODO(), workloadID)
+		assert.True(IsNotFound(err))
+	})
+}
+
+func TestClient_WorkloadRead(t *testing.T) {
+	assert := require.New(t)
+
+	testClient, clientWithResponsesMock := NewTestClient(t)
+
+	clientWithResponsesMock.On("WorkloadsReadWorkloadsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.WorkloadsReadWorkloadsParams) bool {
+			return aws.ToInt(params.Offset) == 0
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.WorkloadsReadWorkloadsResp{
+		Body: []byte(`{"Workloads":[{"Id":"first","Outputs":{"BucketName":"first-bucket"}}],"Paging":{"NextOffset":1,"Total":2}}`),
+		JSON200: &models.WorkloadsReadWorkloadsResponse{
+			Workloads: []models.Workload{{Id: aws.String("first")}},
+			Paging:    &models.Pagination{NextOffset: aws.Float32(1), Total: 2},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	clientWithResponsesMock.On("WorkloadsReadWorkloadsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.WorkloadsReadWorkloadsParams) bool {
+			return aws.ToInt(params.Offset) == 1
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.WorkloadsReadWorkloadsResp{
+		Body: []byte(`{"Workloads":[{"Id":"second","Outputs":{"BucketName":"second-bucket"}}],"Paging":{"Total":2}}`),
+		JSON200: &models.WorkloadsReadWorkloadsResponse{
+			Workloads: []models.Workload{{Id: aws.String("second")}},
+			Paging:    &models.Pagination{Total: 2},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	workloadsResp, err := testClient.WorkloadRead(context.TODO(), nil)
+	assert.NoError(err)
+	assert.Len(workloadsResp.JSON200.Workloads, 2)
+
+	// the body contains the workloads from every page
+	outputs, err := WorkloadOutputs(workloadsResp.Body)
+	assert.NoError(err)
+	assert.Equal(map[string]map[string]string{
+		"first":  {"BucketName": "first-bucket"},
+		"second": {"BucketName": "second-bucket"},
+	}, outputs)
+}
+
+func TestClient_AccountRead_EmptyLastPage(t *testing.T) {
+	assert := require.New(t)
+
+	testClient, clientWithResponsesMock := NewTestClient(t)
+
+	clientWithResponsesMock.On("AccountsReadAccountsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.AccountsReadAccountsParams) bool {
+			return aws.ToInt(params.Offset) == 0
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.AccountsReadAccountsResp{
+		JSON200: &models.AccountsReadAccounts{
+			Accounts: []models.Account{{Id: aws.String("first")}},
+			Paging:   &models.Pagination{NextOffset: aws.Float32(1), Total: 2},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	clientWithResponsesMock.On("AccountsReadAccountsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.AccountsReadAccountsParams) bool {
+			return aws.ToInt(params.Offset) == 1
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.AccountsReadAccountsResp{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	accountsResp, err := testClient.AccountRead(context.TODO(), []string{}, []string{})
+	assert.NoError(err)
+	assert.Len(accountsResp.JSON200.Accounts, 1)
+	assert.Equal("first", aws.ToString(accountsResp.JSON200.Accounts[0].Id))
+}
+
+func TestClient_WorkloadRead_EmptyLastPage(t *testing.T) {
+	assert := require.New(t)
+
+	testClient, clientWithResponsesMock := NewTestClient(t)
+
+	clientWithResponsesMock.On("WorkloadsReadWorkloadsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.WorkloadsReadWorkloadsParams) bool {
+			return aws.ToInt(params.Offset) == 0
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.WorkloadsReadWorkloadsResp{
+		Body: []byte(`{"Workloads":[{"Id":"first","Outputs":{"BucketName":"first-bucket"}}],"Paging":{"NextOffset":1,"Total":2}}`),
+		JSON200: &models.WorkloadsReadWorkloadsResponse{
+			Workloads: []models.Workload{{Id: aws.String("first")}},
+			Paging:    &models.Pagination{NextOffset: aws.Float32(1), Total: 2},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	clientWithResponsesMock.On("WorkloadsReadWorkloadsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.WorkloadsReadWorkloadsParams) bool {
+			return aws.ToInt(params.Offset) == 1
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.WorkloadsReadWorkloadsResp{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	workloadsResp, err := testClient.WorkloadRead(context.TODO(), nil)
+	assert.NoError(err)
+	assert.Len(workloadsResp.JSON200.Workloads, 1)
+
+	outputs, err := WorkloadOutputs(workloadsResp.Body)
+	assert.NoError(err)
+	assert.Equal(map[string]map[string]string{
+		"first": {"BucketName": "first-bucket"},
+	}, outputs)
+}
+
+func TestClient_CatalogueItemReadByID(t *testing.T) {
+	catalogueID := "3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11"
+
+	t.Run("returns the catalogue item", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+
+		catalogueItem := models.WorkloadCatalogue{Id: &catalogueID, Name: "vpc"}
+
+		clientWithResponsesMock.On("WorkloadsReadCatalogueItemWithResponse",
+			mock.Anything,
+			catalogueID,
+			mock.AnythingOfType("*models.WorkloadsReadCatalogueItemParams"),
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(&client.WorkloadsReadCatalogueItemResp{
+			JSON200: &models.WorkloadsReadCatalogueItems{
+				WorkloadCatalogues: []models.WorkloadsCatalogue{
+					{WorkloadCatalogueItems: []models.WorkloadCatalogue{catalogueItem}},
+				},
+			},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}, nil)
+
+		catalogueItemResp, err := testClient.CatalogueItemReadByID(context.TODO(), catalogueID)
+		assert.NoError(err)
+		assert.Equal(&catalogueItem, catalogueItemResp)
+	})
+
+	t.Run("returns a not found error for an empty list", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+
+		clientWithResponsesMock.On("WorkloadsReadCatalogueItemWithResponse",
+			mock.Anything,
+			catalogueID,
+			mock.AnythingOfType("*models.WorkloadsReadCatalogueItemParams"),
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(&client.WorkloadsReadCatalogueItemResp{
+			JSON200:      &models.WorkloadsReadCatalogueItems{},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}, nil)
+
+		_, err := testClient.CatalogueItemReadByID(context.TODO(), catalogueID)
+		assert.True(IsNotFound(err))
+	})
+}
+
+func TestClient_CatalogueItemRead(t *testing.T) {
+	assert := require.New(t)
+
+	testClient, clientWithResponsesMock := NewTestClient(t)
+
+	firstPage := &client.WorkloadsReadCatalogueItemsResp{
+		JSON200: &models.WorkloadsReadCatalogueItems{
+			WorkloadCatalogues: []models.WorkloadsCatalogue{
+				{
+					Paging:                 &models.Pagination{NextOffset: aws.Float32(1), Total: 2},
+					WorkloadCatalogueItems: []models.WorkloadCatalogue{{Name: "vpc"}},
+				},
+			},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}
+
+	secondPage := &client.WorkloadsReadCatalogueItemsResp{
+		JSON200: &models.WorkloadsReadCatalogueItems{
+			WorkloadCatalogues: []models.WorkloadsCatalogue{
+				{
+					Paging:                 &models.Pagination{PrevOffset: aws.Float32(0), Total: 2},
+					WorkloadCatalogueItems: []models.WorkloadCatalogue{{Name: "s3-bucket"}},
+				},
+			},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}
+
+	clientWithResponsesMock.On("WorkloadsReadCatalogueItemsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.WorkloadsReadCatalogueItemsParams) bool {
+			return aws.ToInt(params.Offset) == 0
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(firstPage, nil).Once()
+
+	clientWithResponsesMock.On("WorkloadsReadCatalogueItemsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *models.WorkloadsReadCatalogueItemsParams) bool {
+			return aws.ToInt(params.Offset) == 1
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(secondPage, nil).Once()
+
+	catalogueItems, err := testClient.CatalogueItemRead(context.TODO(), &models.WorkloadsReadCatalogueItemsParams{})
+	assert.NoError(err)
+	assert.Len(catalogueItems, 2)
+	assert.Equal("vpc", catalogueItems[0].Name)
+	assert.Equal("s3-bucket", catalogueItems[1].Name)
+}
+
+func TestClient_NetworkingHubReadByID(t *testing.T) {
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+
+	t.Run("returns the networking hub", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+
+		hubs := &models.NetworkingReadHubs{
+			Hubs: []models.NetworkingHub{
+				{Id: &hubID, Name: "core-hub", Region: "ap-southeast-2"},
+			},
+		}
+
+		clientWithResponsesMock.On("NetworkingReadHubWithResponse",
+			mock.Anything,
+			hubID,
+			mock.AnythingOfType("*models.NetworkingReadHubParams"),
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(&client.NetworkingReadHubResp{
+			JSON200:      hubs,
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}, nil)
+
+		hubResp, err := testClient.NetworkingHubReadByID(context.TODO(), hubID)
+		assert.NoError(err)
+		assert.Equal(hubs, hubResp.JSON200)
+	})
+
+	t.Run("returns a not found error for an empty list", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+
+		clientWithResponsesMock.On("NetworkingReadHubWithResponse",
+			mock.Anything,
+			hubID,
+			mock.AnythingOfType("*models.NetworkingReadHubParams"),
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(&client.NetworkingReadHubResp{
+			JSON200:      &models.NetworkingReadHubs{},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}, nil)
+
+		_, err := testClient.NetworkingHubReadByID(context.TODO(), hubID)
+		assert.True(IsNotFound(err))
+	})
+}
+
+func TestClient_MonitorTask(t *testing.T) {
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	taskStatus := func(status models.OperationStatus) *client.TasksReadTaskResp {
+		return &client.TasksReadTaskResp{
+			JSON200:      &models.TasksReadTask{Status: status},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}
+	}
+
+	t.Run("a deadline on the context replaces the poller timeout", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+		testClient.pollerConfig = helpers.PollerConfig{Backoff: helpers.ExponentialBackoff{InitialInterval: 5 * time.Millisecond}, Timeout: time.Millisecond}
+
+		clientWithResponsesMock.On("TasksReadTaskWithResponse",
+			mock.Anything,
+			taskID,
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(taskStatus(TaskStarted), nil).Twice()
+
+		clientWithResponsesMock.On("TasksReadTaskWithResponse",
+			mock.Anything,
+			taskID,
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(taskStatus(TaskSucceeded), nil).Once()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		taskResp, err := testClient.MonitorTask(ctx, taskID, func(ctx context.Context, resp *client.TasksReadTaskResp) bool {
+			return true
+		})
+		assert.NoError(err)
+		assert.Equal(TaskSucceeded, taskResp.JSON200.Status)
+	})
+
+	t.Run("returns the context error once the deadline elapses", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+		testClient.pollerConfig = helpers.PollerConfig{Backoff: helpers.ExponentialBackoff{InitialInterval: time.Millisecond}, Timeout: time.Minute}
+
+		clientWithResponsesMock.On("TasksReadTaskWithResponse",
+			mock.Anything,
+			taskID,
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(taskStatus(TaskStarted), nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := testClient.MonitorTask(ctx, taskID, func(ctx context.Context, resp *client.TasksReadTaskResp) bool {
+			return true
+		})
+		assert.ErrorIs(err, context.DeadlineExceeded)
+	})
+}
+
+func TestClient_MonitorVpnConnectionTunnels(t *testing.T) {
+	connectionID := "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+
+	tunnelStatus := func(statuses ...models.TunnelMappingTunnelStatus) *client.NetworkingReadVpnConnectionStatusResp {
+		tunnels := make(models.ConnectionStatus, 0, len(statuses))
+		for i := range statuses {
+			tunnels = append(tunnels, models.TunnelMapping{TunnelName: aws.String(fmt.Sprintf("Tunnel%d", i+1)), TunnelStatus: &statuses[i]})
+		}
+
+		return &client.NetworkingReadVpnConnectionStatusResp{
+			JSON200:      &models.NetworkingReadVpnConnectionStatus{ConnectionStatus: &tunnels},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}
+	}
+
+	t.Run("polls until all the tunnels are up", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+		testClient.pollerConfig = helpers.PollerConfig{Backoff: helpers.ExponentialBackoff{InitialInterval: time.Millisecond}}
+
+		clientWithResponsesMock.On("NetworkingReadVpnConnectionStatusWithResponse",
+			mock.Anything,
+			connectionID,
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(tunnelStatus(models.Up, models.Down), nil).Once()
+
+		clientWithResponsesMock.On("NetworkingReadVpnConnectionStatusWithResponse",
+			mock.Anything,
+			connectionID,
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(tunnelStatus(models.Up, models.Up), nil).Once()
+
+		statusResp, err := testClient.MonitorVpnConnectionTunnels(context.TODO(), connectionID, time.Minute, func(ctx context.Context, resp *client.NetworkingReadVpnConnectionStatusResp) bool {
+			return true
+		})
+		assert.NoError(err)
+		assert.True(AreVpnTunnelsUp(statusResp.JSON200))
+	})
+
+	t.Run("returns a timeout error with the last tunnel status", func(t *testing.T) {
+		assert := require.New(t)
+
+		testClient, clientWithResponsesMock := NewTestClient(t)
+		testClient.pollerConfig = helpers.PollerConfig{Backoff: helpers.ExponentialBackoff{InitialInterval: time.Millisecond}}
+
+		clientWithResponsesMock.On("NetworkingReadVpnConnectionStatusWithResponse",
+			mock.Anything,
+			connectionID,
+			mock.AnythingOfType("client.RequestEditorFn"),
+		).Return(tunnelStatus(models.Up, models.Down), nil)
+
+		_, err := testClient.MonitorVpnConnectionTunnels(context.TODO(), connectionID, 20*time.Millisecond, func(ctx context.Context, resp *client.NetworkingReadVpnConnectionStatusResp) bool {
+			return true
+		})
+		assert.ErrorIs(err, helpers.ErrTaskTimeout)
+		assert.ErrorContains(err, "Tunnel2=down")
+	})
+}
+
 func TestClient_GroupRead(t *testing.T) {
 	assert := require.New(t)
 
@@ -359,6 +834,87 @@ func TestClient_PermissionSetsList(t *testing.T) {
 	assert.Equal(&permissionssetsmodels.ListPermissionSets{PermissionSets: []permissionssetsmodels.PermissionSetRecord{permissionSetRecord}}, permissionSetResp.JSON200)
 }
 
+func TestClient_PermissionSetsList_EmptyLastPage(t *testing.T) {
+	assert := require.New(t)
+	permissionSetID := "b549185e-0fd7-44cf-a7b5-0751c720c0f0"
+
+	testClient, clientWithResponsesMock := NewTestPermissionSetsClient(t)
+
+	permissionSetRecord := permissionssetsmodels.PermissionSetRecord{
+		Id: uuid.MustParse(permissionSetID),
+	}
+
+	clientWithResponsesMock.On("ListPermissionSetsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *permissionssetsmodels.ListPermissionSetsParams) bool {
+			return params.PageToken == nil
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&permissionssetsclient.ListPermissionSetsResponse{
+		JSON200: &permissionssetsmodels.ListPermissionSets{
+			PermissionSets: []permissionssetsmodels.PermissionSetRecord{permissionSetRecord},
+			Paging:         &permissionssetsmodels.Paging{NextToken: aws.String("next")},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	clientWithResponsesMock.On("ListPermissionSetsWithResponse",
+		mock.Anything,
+		mock.MatchedBy(func(params *permissionssetsmodels.ListPermissionSetsParams) bool {
+			return aws.ToString(params.PageToken) == "next"
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&permissionssetsclient.ListPermissionSetsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	permissionSetResp, err := testClient.PermissionSetsList(context.TODO(), nil)
+	assert.NoError(err)
+	assert.Equal(&permissionssetsmodels.ListPermissionSets{PermissionSets: []permissionssetsmodels.PermissionSetRecord{permissionSetRecord}}, permissionSetResp.JSON200)
+}
+
+func TestClient_PermissionSetAssignmentList_EmptyLastPage(t *testing.T) {
+	assert := require.New(t)
+	permissionSetID := "b549185e-0fd7-44cf-a7b5-0751c720c0f0"
+	assignmentID := "0f1e2d3c-4b5a-4968-8776-5a4b3c2d1e0f"
+
+	testClient, clientWithResponsesMock := NewTestPermissionSetsClient(t)
+
+	assignmentRecord := permissionssetsmodels.AssignmentRecord{
+		Id: uuid.MustParse(assignmentID),
+	}
+
+	clientWithResponsesMock.On("ListPermissionSetAssignmentsWithResponse",
+		mock.Anything,
+		uuid.MustParse(permissionSetID),
+		mock.MatchedBy(func(params *permissionssetsmodels.ListPermissionSetAssignmentsParams) bool {
+			return params.PageToken == nil
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&permissionssetsclient.ListPermissionSetAssignmentsResponse{
+		JSON200: &permissionssetsmodels.ListAssignmentRecords{
+			Assignments: permissionssetsmodels.AssignmentRecords{assignmentRecord},
+			Paging:      &permissionssetsmodels.Paging{NextToken: aws.String("next")},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	clientWithResponsesMock.On("ListPermissionSetAssignmentsWithResponse",
+		mock.Anything,
+		uuid.MustParse(permissionSetID),
+		mock.MatchedBy(func(params *permissionssetsmodels.ListPermissionSetAssignmentsParams) bool {
+			return aws.ToString(params.PageToken) == "next"
+		}),
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&permissionssetsclient.ListPermissionSetAssignmentsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	assignmentsResp, err := testClient.PermissionSetAssignmentList(context.TODO(), permissionSetID, nil)
+	assert.NoError(err)
+	assert.Equal(&permissionssetsmodels.ListAssignmentRecords{Assignments: permissionssetsmodels.AssignmentRecords{assignmentRecord}}, assignmentsResp.JSON200)
+}
+
 func TestClient_PermissionSetsCreate(t *testing.T) {
 	assert := require.New(t)
 	permissionSetID := "b549185e-0fd7-44cf-a7b5-0751c720c0f0"
@@ -540,3 +1096,51 @@ func TestGetInstallationCoreAPIURL(t *testing.T) {
 		})
 	}
 }
+
+func TestAPITokenProviderAuthFn(t *testing.T) {
+	assert := require.New(t)
+
+	apiTokenProvider := &testAPITokenProvider{apiToken: &auth.APIToken{AccessKey: "first", SecretKey: "secret"}}
+
+	authenticated := []string{}
+	refreshed := 0
+
+	authFn := func(ctx context.Context, client client.ClientWithResponsesInterface, apiToken *auth.APIToken) (*auth.AuthResponse, error) {
+		authenticated = append(authenticated, apiToken.AccessKey)
+
+		return &auth.AuthResponse{
+			Refresh: func(ctx context.Context) (*auth.AuthResponse, error) {
+				refreshed++
+				return &auth.AuthResponse{Refresh: func(ctx context.Context) (*auth.AuthResponse, error) {
+					refreshed++
+					return &auth.AuthResponse{}, nil
+				}}, nil
+			},
+		}, nil
+	}
+
+	authResponse, err := apiTokenProviderAuthFn(apiTokenProvider, authFn)(context.TODO(), nil, nil)
+	assert.NoError(err)
+	assert.Equal([]string{"first"}, authenticated)
+
+	// the session is refreshed while the api token is unchanged
+	authResponse, err = authResponse.Refresh(context.TODO())
+	assert.NoError(err)
+	assert.Equal(1, refreshed)
+
+	// the client authenticates again once the api token is rotated
+	apiTokenProvider.apiToken = &auth.APIToken{AccessKey: "second", SecretKey: "secret"}
+
+	_, err = authResponse.Refresh(context.TODO())
+	assert.NoError(err)
+	assert.Equal(1, refreshed)
+	assert.Equal([]string{"first", "second"}, authenticated)
+}
+
+type testAPITokenProvider struct {
+	apiToken *auth.APIToken
+}
+
+func (p *testAPITokenProvider) Retrieve(ctx context.Context) (*auth.APIToken, error) {
+	return p.apiToken, nil
+}
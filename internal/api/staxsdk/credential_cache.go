@@ -0,0 +1,67 @@
+package staxsdk
+
+import (
+	"context"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+)
+
+// CredentialCache caches authenticated sessions so they can be reused by other clients, see cache.FileCache.
+type CredentialCache interface {
+	// Load returns the cached session for the installation and API token, if there is one which is still valid.
+	Load(installation string, apiToken *auth.APIToken) (*auth.AuthResponse, bool)
+	// Store caches the session for the installation and API token.
+	Store(ctx context.Context, installation string, apiToken *auth.APIToken, authResponse *auth.AuthResponse) error
+}
+
+// WithCredentialCache sets a cache used to share authenticated sessions between clients, this avoids
+// repeating the full authentication each time a client is created.
+func WithCredentialCache(credentialCache CredentialCache) ClientOption {
+	return func(c *Client) {
+		c.credentialCache = credentialCache
+	}
+}
+
+// cachedAuthFn wraps the AuthFn, returning a cached session when one is available and caching new sessions.
+//
+// A session loaded from the cache is refreshed by running the AuthFn, as the Cognito refresh token is not cached.
+func cachedAuthFn(credentialCache CredentialCache, installation string, authFn AuthFn) AuthFn {
+	return func(ctx context.Context, client client.ClientWithResponsesInterface, apiToken *auth.APIToken) (*auth.AuthResponse, error) {
+		authenticate := func(ctx context.Context) (*auth.AuthResponse, error) {
+			authResponse, err := authFn(ctx, client, apiToken)
+			if err != nil {
+				return nil, err
+			}
+
+			return cacheAuthResponse(ctx, credentialCache, installation, apiToken, authResponse), nil
+		}
+
+		if authResponse, ok := credentialCache.Load(installation, apiToken); ok {
+			authResponse.Refresh = authenticate
+			return authResponse, nil
+		}
+
+		return authenticate(ctx)
+	}
+}
+
+// cacheAuthResponse stores the session in the cache and wraps its refresher so refreshed sessions are also cached.
+//
+// The cache is best effort, failing to store the session does not fail authentication.
+func cacheAuthResponse(ctx context.Context, credentialCache CredentialCache, installation string, apiToken *auth.APIToken, authResponse *auth.AuthResponse) *auth.AuthResponse {
+	_ = credentialCache.Store(ctx, installation, apiToken, authResponse)
+
+	if refresh := authResponse.Refresh; refresh != nil {
+		authResponse.Refresh = func(ctx context.Context) (*auth.AuthResponse, error) {
+			refreshed, err := refresh(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			return cacheAuthResponse(ctx, credentialCache, installation, apiToken, refreshed), nil
+		}
+	}
+
+	return authResponse
+}
@@ -0,0 +1,80 @@
+package staxsdk
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedAuthFn(t *testing.T) {
+	assert := require.New(t)
+
+	apiToken := &auth.APIToken{AccessKey: "b549185e-0fd7-44cf-a7b5-0751c720c0f0", SecretKey: "secret"}
+	credentialCache := &testCredentialCache{entries: map[string]*auth.AuthResponse{}}
+
+	var authCount, refreshCount int32
+	authFn := func(ctx context.Context, client client.ClientWithResponsesInterface, apiToken *auth.APIToken) (*auth.AuthResponse, error) {
+		atomic.AddInt32(&authCount, 1)
+
+		return &auth.AuthResponse{
+			Refresh: func(ctx context.Context) (*auth.AuthResponse, error) {
+				atomic.AddInt32(&refreshCount, 1)
+				return &auth.AuthResponse{}, nil
+			},
+		}, nil
+	}
+
+	cachedFn := cachedAuthFn(credentialCache, "au1", authFn)
+
+	// the first authentication is cached
+	authResponse, err := cachedFn(context.TODO(), nil, apiToken)
+	assert.NoError(err)
+	assert.Equal(int32(1), atomic.LoadInt32(&authCount))
+	assert.Equal(1, credentialCache.stores)
+
+	// refreshed sessions are cached
+	_, err = authResponse.Refresh(context.TODO())
+	assert.NoError(err)
+	assert.Equal(int32(1), atomic.LoadInt32(&refreshCount))
+	assert.Equal(2, credentialCache.stores)
+
+	// the next authentication is loaded from the cache
+	authResponse, err = cachedFn(context.TODO(), nil, apiToken)
+	assert.NoError(err)
+	assert.Equal(int32(1), atomic.LoadInt32(&authCount))
+
+	// a session loaded from the cache is refreshed with a full authentication
+	_, err = authResponse.Refresh(context.TODO())
+	assert.NoError(err)
+	assert.Equal(int32(2), atomic.LoadInt32(&authCount))
+	assert.Equal(3, credentialCache.stores)
+}
+
+type testCredentialCache struct {
+	entries map[string]*auth.AuthResponse
+	stores  int
+}
+
+func (c *testCredentialCache) Load(installation string, apiToken *auth.APIToken) (*auth.AuthResponse, bool) {
+	authResponse, ok := c.entries[installation+apiToken.AccessKey]
+	if !ok {
+		return nil, false
+	}
+
+	// return a copy as the cache would
+	cached := *authResponse
+	cached.Refresh = nil
+
+	return &cached, true
+}
+
+func (c *testCredentialCache) Store(ctx context.Context, installation string, apiToken *auth.APIToken, authResponse *auth.AuthResponse) error {
+	c.stores++
+	c.entries[installation+apiToken.AccessKey] = authResponse
+
+	return nil
+}
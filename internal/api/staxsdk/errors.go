@@ -0,0 +1,166 @@
+package staxsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// requestIDHeader is the header API Gateway uses to return the request identifier.
+const requestIDHeader = "x-amzn-RequestId"
+
+// ErrNotFound is matched by errors returned when the requested resource does not exist, either as a 404 status or
+// an empty list of results.
+var ErrNotFound = errors.New("not found")
+
+// APIError is returned when the Stax API responds with an unexpected status code.
+type APIError struct {
+	// Operation is the name of the Stax API operation which failed, for example "AccountsCreateAccount".
+	Operation string
+
+	// StatusCode is the HTTP status code returned by the Stax API.
+	StatusCode int
+
+	// Status is the HTTP status line returned by the Stax API.
+	Status string
+
+	// RequestID is the API Gateway request identifier, this is useful when raising issues with Stax support.
+	RequestID string
+
+	// Message is the error message decoded from the response body, this may be empty.
+	Message string
+}
+
+// Error returns a description of the failed request.
+func (e *APIError) Error() string {
+	tokens := []string{fmt.Sprintf("%s request failed, returned status: %s", e.Operation, e.Status)}
+
+	if e.RequestID != "" {
+		tokens = append(tokens, fmt.Sprintf("request id: %s", e.RequestID))
+	}
+
+	if e.Message != "" {
+		tokens = append(tokens, fmt.Sprintf("error: %s", e.Message))
+	}
+
+	return strings.Join(tokens, ", ")
+}
+
+// Is reports whether target is ErrNotFound and the status code is 404.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// NotFoundError is returned when the Stax API responds successfully without the requested resource, it matches ErrNotFound.
+type NotFoundError struct {
+	// Resource is the type of resource which was requested, for example "account".
+	Resource string
+
+	// ID is the identifier of the resource which was requested.
+	ID string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found for identifier: %s", e.Resource, e.ID)
+}
+
+// Is reports whether target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// IsNotFound returns true if the error is an APIError with a 404 status code, or a NotFoundError.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsBadRequest returns true if the error is an APIError with a 400 status code.
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsConflict returns true if the error is an APIError with a 409 status code.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsThrottled returns true if the error is an APIError with a 429 status code.
+func IsThrottled(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized returns true if the error is an APIError with a 401 or 403 status code.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized) || hasStatusCode(err, http.StatusForbidden)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+
+	return false
+}
+
+func newAPIError(operation string, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		Operation: operation,
+		Message:   decodeErrorMessage(body),
+	}
+
+	if res != nil {
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Status = res.Status
+		apiErr.RequestID = res.Header.Get(requestIDHeader)
+	}
+
+	if apiErr.Status == "" {
+		apiErr.Status = fmt.Sprintf("%d %s", apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+	}
+
+	return apiErr
+}
+
+// decodeErrorMessage extracts the error message from the body of a failed request.
+//
+// The core API returns {"Error": "...", "Cause": "..."}, the permission sets API returns
+// {"Error": {"Code": 400, "Message": "..."}} and API Gateway returns {"message": "..."}.
+func decodeErrorMessage(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var coreErr struct {
+		Error *string `json:"Error"`
+		Cause *string `json:"Cause"`
+	}
+	if err := json.Unmarshal(body, &coreErr); err == nil && coreErr.Error != nil {
+		if coreErr.Cause != nil && *coreErr.Cause != "" {
+			return fmt.Sprintf("%s: %s", *coreErr.Error, *coreErr.Cause)
+		}
+
+		return *coreErr.Error
+	}
+
+	var permissionSetsErr struct {
+		Error struct {
+			Code    int32  `json:"Code"`
+			Message string `json:"Message"`
+		} `json:"Error"`
+	}
+	if err := json.Unmarshal(body, &permissionSetsErr); err == nil && permissionSetsErr.Error.Message != "" {
+		return permissionSetsErr.Error.Message
+	}
+
+	var gatewayErr struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &gatewayErr); err == nil && gatewayErr.Message != "" {
+		return gatewayErr.Message
+	}
+
+	return ""
+}
@@ -0,0 +1,112 @@
+package staxsdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError_Error(t *testing.T) {
+	assert := require.New(t)
+
+	apiErr := &APIError{
+		Operation:  "TeamsReadGroup",
+		StatusCode: http.StatusNotFound,
+		Status:     "404 Not Found",
+		RequestID:  "8b1f5a3c-9b61-4d2a-8a3b-cbd1b1d6c0a7",
+		Message:    "Group not found",
+	}
+
+	assert.Equal("TeamsReadGroup request failed, returned status: 404 Not Found, request id: 8b1f5a3c-9b61-4d2a-8a3b-cbd1b1d6c0a7, error: Group not found", apiErr.Error())
+}
+
+func TestAPIError_StatusChecks(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		badRequest   bool
+		conflict     bool
+		throttled    bool
+		unauthorized bool
+	}{
+		{name: "not found", err: &APIError{StatusCode: http.StatusNotFound}, notFound: true},
+		{name: "bad request", err: &APIError{StatusCode: http.StatusBadRequest}, badRequest: true},
+		{name: "conflict", err: &APIError{StatusCode: http.StatusConflict}, conflict: true},
+		{name: "throttled", err: &APIError{StatusCode: http.StatusTooManyRequests}, throttled: true},
+		{name: "unauthorized", err: &APIError{StatusCode: http.StatusUnauthorized}, unauthorized: true},
+		{name: "forbidden", err: &APIError{StatusCode: http.StatusForbidden}, unauthorized: true},
+		{name: "wrapped not found", err: fmt.Errorf("task failed: %w", &APIError{StatusCode: http.StatusNotFound}), notFound: true},
+		{name: "empty results", err: &NotFoundError{Resource: "account", ID: "b3a7e5f0-6c1d-4a4e-9a49-0f8c2c3e5d11"}, notFound: true},
+		{name: "wrapped empty results", err: fmt.Errorf("read failed: %w", &NotFoundError{Resource: "group", ID: "87c570e2-c795-44b0-aefa-ebdcffd4d048"}), notFound: true},
+		{name: "server error", err: &APIError{StatusCode: http.StatusInternalServerError}},
+		{name: "other error", err: fmt.Errorf("connection refused")},
+		{name: "nil error", err: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(tt.notFound, IsNotFound(tt.err))
+			assert.Equal(tt.badRequest, IsBadRequest(tt.err))
+			assert.Equal(tt.conflict, IsConflict(tt.err))
+			assert.Equal(tt.throttled, IsThrottled(tt.err))
+			assert.Equal(tt.unauthorized, IsUnauthorized(tt.err))
+		})
+	}
+}
+
+func TestDecodeErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "core error", body: `{"Error": "Invalid account name"}`, want: "Invalid account name"},
+		{name: "core error with cause", body: `{"Error": "Invalid account name", "Cause": "name is too long"}`, want: "Invalid account name: name is too long"},
+		{name: "permission sets error", body: `{"Error": {"Code": 400, "Message": "Invalid policy arn"}}`, want: "Invalid policy arn"},
+		{name: "api gateway error", body: `{"message": "Forbidden"}`, want: "Forbidden"},
+		{name: "not json", body: `<html></html>`, want: ""},
+		{name: "empty", body: ``, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(tt.want, decodeErrorMessage([]byte(tt.body)))
+		})
+	}
+}
+
+func TestClient_GroupReadByID_NotFound(t *testing.T) {
+	assert := require.New(t)
+	groupId := "b549185e-0fd7-44cf-a7b5-0751c720c0f0"
+
+	testClient, clientWithResponsesMock := NewTestClient(t)
+
+	clientWithResponsesMock.On("TeamsReadGroupWithResponse",
+		mock.Anything,
+		groupId,
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&client.TeamsReadGroupResp{
+		Body: []byte(`{"Error": "Group not found"}`),
+		HTTPResponse: &http.Response{
+			StatusCode: http.StatusNotFound,
+			Status:     "404 Not Found",
+			Header:     http.Header{"X-Amzn-Requestid": []string{"8b1f5a3c-9b61-4d2a-8a3b-cbd1b1d6c0a7"}},
+		},
+	}, nil)
+
+	_, err := testClient.GroupReadByID(context.TODO(), groupId)
+	assert.Error(err)
+	assert.True(IsNotFound(err))
+
+	var apiErr *APIError
+	assert.ErrorAs(err, &apiErr)
+	assert.Equal("TeamsReadGroup", apiErr.Operation)
+	assert.Equal("8b1f5a3c-9b61-4d2a-8a3b-cbd1b1d6c0a7", apiErr.RequestID)
+	assert.Equal("Group not found", apiErr.Message)
+}
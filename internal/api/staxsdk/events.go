@@ -0,0 +1,102 @@
+package staxsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+)
+
+// ErrEventTaskIDMissing is returned when an event response does not contain the identifier of the task it started.
+var ErrEventTaskIDMissing = errors.New("event response does not contain a task id")
+
+// ErrEventCatalogueIDMissing is returned when an event response does not contain the identifier of the workload catalogue item.
+var ErrEventCatalogueIDMissing = errors.New("event response does not contain a catalogue id")
+
+// eventResponse is the part of an asynchronous event response which identifies the task and the resource it changes,
+// the generated models for several events, such as models.UpdateWorkloadEvent, omit these fields.
+type eventResponse struct {
+	TaskId      *string              `json:"TaskId,omitempty"`
+	CatalogueId *string              `json:"CatalogueId,omitempty"`
+	Detail      *eventResponseDetail `json:"Detail,omitempty"`
+}
+
+type eventResponseDetail struct {
+	models.BaseEventDetail
+	WorkloadCatalogueItem *models.CatalogueData `json:"WorkloadCatalogueItem,omitempty"`
+}
+
+func decodeEventResponse(body []byte) (*eventResponse, error) {
+	event := new(eventResponse)
+
+	err := json.Unmarshal(body, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode event response: %w", err)
+	}
+
+	return event, nil
+}
+
+//	EventTaskID returns the identifier of the task started by an asynchronous request, read from the event response body.
+//
+// body: The body of the event response, for example WorkloadsUpdateWorkloadResp.Body.
+//
+// Returns:
+// - taskID: The identifier of the task, this can be passed to MonitorTask.
+// - err: ErrEventTaskIDMissing if the event does not contain a task id, or an error decoding the body.
+func EventTaskID(body []byte) (string, error) {
+	event, err := decodeEventResponse(body)
+	if err != nil {
+		return "", err
+	}
+
+	// the workload catalogue events return the task id at the top level
+	if event.TaskId != nil && *event.TaskId != "" {
+		return *event.TaskId, nil
+	}
+
+	if event.Detail == nil || event.Detail.Message == nil {
+		return "", ErrEventTaskIDMissing
+	}
+
+	// the message is either an object containing the task id, or a string
+	message, err := event.Detail.Message.AsMessageEventDetail0()
+	if err != nil || message.TaskId == nil || *message.TaskId == "" {
+		return "", ErrEventTaskIDMissing
+	}
+
+	return *message.TaskId, nil
+}
+
+//	EventCatalogueID returns the identifier of the workload catalogue item changed by an asynchronous request.
+//
+// body: The body of the event response, for example WorkloadsCreateCatalogueItemResp.Body.
+//
+// Returns:
+// - catalogueID: The identifier of the workload catalogue item.
+// - err: ErrEventCatalogueIDMissing if the event does not contain a catalogue id, or an error decoding the body.
+func EventCatalogueID(body []byte) (string, error) {
+	event, err := decodeEventResponse(body)
+	if err != nil {
+		return "", err
+	}
+
+	if event.CatalogueId != nil && *event.CatalogueId != "" {
+		return *event.CatalogueId, nil
+	}
+
+	if event.Detail != nil && event.Detail.WorkloadCatalogueItem != nil {
+		item := event.Detail.WorkloadCatalogueItem
+
+		if item.CatalogueId != nil && *item.CatalogueId != "" {
+			return *item.CatalogueId, nil
+		}
+
+		if item.Id != nil && *item.Id != "" {
+			return *item.Id, nil
+		}
+	}
+
+	return "", ErrEventCatalogueIDMissing
+}
@@ -0,0 +1,97 @@
+package staxsdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTaskID(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		taskID  string
+		wantErr error
+	}{
+		{
+			name:   "returns the task id from the message",
+			body:   `{"DetailType":"stax.workload.update","Detail":{"Message":{"TaskId":"fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"},"Operation":"UPDATE","OperationStatus":"STARTED","Severity":"INFO"}}`,
+			taskID: "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1",
+		},
+		{
+			name:   "returns the task id from a workload catalogue event",
+			body:   `{"DetailType":"stax.workload.catalogue.create","TaskId":"6c0f0a1e-2b7a-4d36-9f0e-8d7d3c1b2a90","CatalogueId":"3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11","TraceId":"1-abc"}`,
+			taskID: "6c0f0a1e-2b7a-4d36-9f0e-8d7d3c1b2a90",
+		},
+		{
+			name:    "returns ErrEventTaskIDMissing for a string message",
+			body:    `{"DetailType":"stax.workload.update","Detail":{"Message":"workload update started","Operation":"UPDATE","OperationStatus":"STARTED","Severity":"INFO"}}`,
+			wantErr: ErrEventTaskIDMissing,
+		},
+		{
+			name:    "returns ErrEventTaskIDMissing without a detail",
+			body:    `{"DetailType":"stax.workload.update"}`,
+			wantErr: ErrEventTaskIDMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			taskID, err := EventTaskID([]byte(tt.body))
+			if tt.wantErr != nil {
+				assert.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(err)
+			assert.Equal(tt.taskID, taskID)
+		})
+	}
+
+	t.Run("returns an error for an invalid body", func(t *testing.T) {
+		_, err := EventTaskID([]byte("<html></html>"))
+		require.ErrorContains(t, err, "failed to decode event response")
+	})
+}
+
+func TestEventCatalogueID(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		catalogueID string
+		wantErr     error
+	}{
+		{
+			name:        "returns the catalogue id from the event",
+			body:        `{"DetailType":"stax.workload.catalogue.create","TaskId":"6c0f0a1e-2b7a-4d36-9f0e-8d7d3c1b2a90","CatalogueId":"3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11"}`,
+			catalogueID: "3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11",
+		},
+		{
+			name:        "returns the catalogue id from the detail",
+			body:        `{"DetailType":"stax.workload.catalogue.create","Detail":{"WorkloadCatalogueItem":{"CatalogueId":"3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11","Name":"vpc","Description":"vpc"}}}`,
+			catalogueID: "3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11",
+		},
+		{
+			name:    "returns ErrEventCatalogueIDMissing without a catalogue id",
+			body:    `{"DetailType":"stax.workload.catalogue.create","TaskId":"6c0f0a1e-2b7a-4d36-9f0e-8d7d3c1b2a90"}`,
+			wantErr: ErrEventCatalogueIDMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			catalogueID, err := EventCatalogueID([]byte(tt.body))
+			if tt.wantErr != nil {
+				assert.ErrorIs(err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(err)
+			assert.Equal(tt.catalogueID, catalogueID)
+		})
+	}
+}
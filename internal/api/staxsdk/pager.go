@@ -0,0 +1,154 @@
+package staxsdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	permissionssetsmodels "github.com/stax-labs/terraform-provider-stax/internal/api/openapi/permissionssets/models"
+)
+
+// DefaultPageSize is the number of items requested for each page of a paginated list operation.
+const DefaultPageSize = 100
+
+// ErrRepeatedPageCursor is returned when a list operation returns a cursor which has already been fetched,
+// this guards against looping forever on a misbehaving API.
+var ErrRepeatedPageCursor = errors.New("pagination cursor was repeated, results may be incomplete")
+
+// OffsetPageFunc fetches the page of items at offset, returning the offset of the next page or nil if it is the last page.
+type OffsetPageFunc[T any] func(ctx context.Context, offset int) ([]T, *int, error)
+
+// TokenPageFunc fetches the page of items for pageToken, which is nil for the first page, returning the token of the
+// next page or nil if it is the last page.
+type TokenPageFunc[T any] func(ctx context.Context, pageToken *string) ([]T, *string, error)
+
+// Pager iterates over the pages of a paginated list operation.
+//
+//	pager := NewTokenPager(pageFunc)
+//	for pager.Next(ctx) {
+//		items = append(items, pager.Page()...)
+//	}
+//	if err := pager.Err(); err != nil {
+//		return err
+//	}
+type Pager[T any] struct {
+	fetchFunc func(ctx context.Context) ([]T, bool, error)
+	page      []T
+	done      bool
+	err       error // Sticky error.
+}
+
+// NewOffsetPager creates a Pager for list operations which use offset and limit parameters, starting at offset 0.
+func NewOffsetPager[T any](pageFunc OffsetPageFunc[T]) *Pager[T] {
+	return newCursorPager[T, int](aws.Int(0), func(ctx context.Context, offset *int) ([]T, *int, error) {
+		return pageFunc(ctx, *offset)
+	})
+}
+
+// NewTokenPager creates a Pager for list operations which use an opaque page token.
+func NewTokenPager[T any](pageFunc TokenPageFunc[T]) *Pager[T] {
+	return newCursorPager[T, string](nil, func(ctx context.Context, pageToken *string) ([]T, *string, error) {
+		items, next, err := pageFunc(ctx, pageToken)
+		if next != nil && *next == "" {
+			next = nil
+		}
+
+		return items, next, err
+	})
+}
+
+func newCursorPager[T any, C comparable](cursor *C, pageFunc func(ctx context.Context, cursor *C) ([]T, *C, error)) *Pager[T] {
+	seen := map[C]struct{}{}
+
+	return &Pager[T]{
+		fetchFunc: func(ctx context.Context) ([]T, bool, error) {
+			items, next, err := pageFunc(ctx, cursor)
+			if err != nil {
+				return nil, false, err
+			}
+
+			if next == nil {
+				return items, false, nil
+			}
+
+			if cursor != nil {
+				seen[*cursor] = struct{}{}
+			}
+
+			if _, ok := seen[*next]; ok {
+				return nil, false, ErrRepeatedPageCursor
+			}
+
+			cursor = next
+
+			return items, true, nil
+		},
+	}
+}
+
+// Next fetches the next page, returning false when there are no more pages or an error occurred.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	page, more, err := p.fetchFunc(ctx)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.page = page
+	p.done = !more
+
+	return true
+}
+
+// Page returns the items of the page fetched by the last call to Next.
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// Err returns the first error encountered while paging.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// CollectPages walks all the pages of the pager, returning every item.
+func CollectPages[T any](ctx context.Context, pager *Pager[T]) ([]T, error) {
+	items := []T{}
+
+	for pager.Next(ctx) {
+		items = append(items, pager.Page()...)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func nextOffset(paging *models.Pagination) *int {
+	if paging == nil || paging.NextOffset == nil {
+		return nil
+	}
+
+	offset := int(*paging.NextOffset)
+
+	return &offset
+}
+
+func nextPageToken(paging *permissionssetsmodels.Paging) *string {
+	if paging == nil {
+		return nil
+	}
+
+	return paging.NextToken
+}
@@ -0,0 +1,142 @@
+package staxsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/uuid"
+	permissionssetsclient "github.com/stax-labs/terraform-provider-stax/internal/api/openapi/permissionssets/client"
+	permissionssetsmodels "github.com/stax-labs/terraform-provider-stax/internal/api/openapi/permissionssets/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOffsetPager(t *testing.T) {
+	assert := require.New(t)
+
+	pages := map[int][]string{0: {"a", "b"}, 2: {"c", "d"}, 4: {"e"}}
+	offsets := []int{}
+
+	pager := NewOffsetPager(func(ctx context.Context, offset int) ([]string, *int, error) {
+		offsets = append(offsets, offset)
+
+		if offset == 4 {
+			return pages[offset], nil, nil
+		}
+
+		return pages[offset], aws.Int(offset + 2), nil
+	})
+
+	items, err := CollectPages(context.TODO(), pager)
+	assert.NoError(err)
+	assert.Equal([]string{"a", "b", "c", "d", "e"}, items)
+	assert.Equal([]int{0, 2, 4}, offsets)
+}
+
+func TestTokenPager(t *testing.T) {
+	t.Run("walks all pages", func(t *testing.T) {
+		assert := require.New(t)
+
+		tokens := []*string{}
+
+		pager := NewTokenPager(func(ctx context.Context, pageToken *string) ([]string, *string, error) {
+			tokens = append(tokens, pageToken)
+
+			switch aws.ToString(pageToken) {
+			case "":
+				return []string{"a"}, aws.String("page-2"), nil
+			case "page-2":
+				return []string{"b"}, aws.String(""), nil
+			}
+
+			return nil, nil, errors.New("unexpected page token")
+		})
+
+		items, err := CollectPages(context.TODO(), pager)
+		assert.NoError(err)
+		assert.Equal([]string{"a", "b"}, items)
+		assert.Equal([]*string{nil, aws.String("page-2")}, tokens)
+	})
+
+	t.Run("returns error on repeated token", func(t *testing.T) {
+		assert := require.New(t)
+
+		pager := NewTokenPager(func(ctx context.Context, pageToken *string) ([]string, *string, error) {
+			return []string{"a"}, aws.String("page-2"), nil
+		})
+
+		_, err := CollectPages(context.TODO(), pager)
+		assert.ErrorIs(err, ErrRepeatedPageCursor)
+	})
+
+	t.Run("returns page error", func(t *testing.T) {
+		assert := require.New(t)
+
+		pageErr := errors.New("page failed")
+
+		pager := NewTokenPager(func(ctx context.Context, pageToken *string) ([]string, *string, error) {
+			if pageToken == nil {
+				return []string{"a"}, aws.String("page-2"), nil
+			}
+
+			return nil, nil, pageErr
+		})
+
+		_, err := CollectPages(context.TODO(), pager)
+		assert.ErrorIs(err, pageErr)
+	})
+
+	t.Run("stops when context is cancelled", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		pager := NewTokenPager(func(ctx context.Context, pageToken *string) ([]string, *string, error) {
+			return []string{"a"}, nil, nil
+		})
+
+		assert.False(pager.Next(ctx))
+		assert.ErrorIs(pager.Err(), context.Canceled)
+	})
+}
+
+func TestClient_PermissionSetsList_Paginated(t *testing.T) {
+	assert := require.New(t)
+
+	testClient, clientWithResponsesMock := NewTestPermissionSetsClient(t)
+
+	firstRecord := permissionssetsmodels.PermissionSetRecord{Id: uuid.MustParse("b549185e-0fd7-44cf-a7b5-0751c720c0f0")}
+	secondRecord := permissionssetsmodels.PermissionSetRecord{Id: uuid.MustParse("6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e")}
+
+	clientWithResponsesMock.On("ListPermissionSetsWithResponse",
+		mock.Anything,
+		&permissionssetsmodels.ListPermissionSetsParams{},
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&permissionssetsclient.ListPermissionSetsResponse{
+		JSON200: &permissionssetsmodels.ListPermissionSets{
+			PermissionSets: []permissionssetsmodels.PermissionSetRecord{firstRecord},
+			Paging:         &permissionssetsmodels.Paging{NextToken: aws.String("page-2")},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	clientWithResponsesMock.On("ListPermissionSetsWithResponse",
+		mock.Anything,
+		&permissionssetsmodels.ListPermissionSetsParams{PageToken: aws.String("page-2")},
+		mock.AnythingOfType("client.RequestEditorFn"),
+	).Return(&permissionssetsclient.ListPermissionSetsResponse{
+		JSON200: &permissionssetsmodels.ListPermissionSets{
+			PermissionSets: []permissionssetsmodels.PermissionSetRecord{secondRecord},
+			Paging:         &permissionssetsmodels.Paging{},
+		},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+
+	listResp, err := testClient.PermissionSetsList(context.TODO(), &permissionssetsmodels.ListPermissionSetsParams{})
+	assert.NoError(err)
+	assert.Equal([]permissionssetsmodels.PermissionSetRecord{firstRecord, secondRecord}, listResp.JSON200.PermissionSets)
+}
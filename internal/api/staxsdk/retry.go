@@ -0,0 +1,199 @@
+package staxsdk
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"golang.org/x/exp/slices"
+)
+
+// RetryConfig configures how the client retries throttled and failed requests.
+//
+// Idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried on connection errors and any of the
+// RetryableStatusCodes. Non idempotent requests (POST and PATCH) are only retried when throttled with a 429 status,
+// as API Gateway rejects these before they reach the Stax API.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts made for each request, including the first. A value of 1 disables retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, this is doubled for each subsequent retry.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between retries.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay which is randomised to spread out retries from concurrent requests.
+	Jitter float64
+
+	// RetryableStatusCodes are the response status codes which trigger a retry.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryConfig returns the retry configuration used when WithRetryConfig is not provided.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 5,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    20 * time.Second,
+		Jitter:      0.5,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// retryTransport is a http.RoundTripper which retries throttled and failed requests with exponential backoff.
+//
+// Each retry is re-signed using the current request signer as the SigV4 signature contains a timestamp.
+type retryTransport struct {
+	next       http.RoundTripper
+	cfg        RetryConfig
+	signerFunc func() client.RequestEditorFn
+	randFloat  func() float64
+}
+
+func newRetryTransport(next http.RoundTripper, cfg RetryConfig, signerFunc func() client.RequestEditorFn) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		next:       next,
+		cfg:        cfg,
+		signerFunc: signerFunc,
+		randFloat:  rand.Float64,
+	}
+}
+
+// RoundTrip executes the request, retrying it as configured.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	idempotent := isIdempotent(req.Method)
+
+	attemptReq := req
+
+	for attempt := 1; ; attempt++ {
+		res, err := t.next.RoundTrip(attemptReq)
+
+		if attempt >= t.cfg.MaxAttempts || !t.shouldRetry(ctx, req, idempotent, res, err) {
+			return res, err
+		}
+
+		delay := t.backoff(attempt, res)
+
+		if res != nil {
+			// drain the body so the underlying connection can be reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if err := helpers.SleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		var signer client.RequestEditorFn
+		if t.signerFunc != nil {
+			signer = t.signerFunc()
+		}
+
+		attemptReq, err = newSignedRequest(ctx, req, signer)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(ctx context.Context, req *http.Request, idempotent bool, res *http.Response, err error) bool {
+	// a request body which can't be replayed can't be retried
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotent && ctx.Err() == nil
+	}
+
+	if !slices.Contains(t.cfg.RetryableStatusCodes, res.StatusCode) {
+		return false
+	}
+
+	return idempotent || res.StatusCode == http.StatusTooManyRequests
+}
+
+// backoff returns the delay before the next attempt, honouring any Retry-After header returned with the response.
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	delay := time.Duration(float64(t.cfg.BaseDelay) * math.Pow(2, float64(attempt-1)))
+	if delay > t.cfg.MaxDelay || delay <= 0 {
+		delay = t.cfg.MaxDelay
+	}
+
+	if t.cfg.Jitter > 0 {
+		delay -= time.Duration(t.randFloat() * t.cfg.Jitter * float64(delay))
+	}
+
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok && retryAfter > delay {
+			delay = retryAfter
+			if delay > t.cfg.MaxDelay {
+				delay = t.cfg.MaxDelay
+			}
+		}
+	}
+
+	return delay
+}
+
+// newSignedRequest clones the original request with a fresh body and signature, signer may be nil.
+func newSignedRequest(ctx context.Context, req *http.Request, signer client.RequestEditorFn) (*http.Request, error) {
+	attemptReq := req.Clone(ctx)
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		attemptReq.Body = body
+	}
+
+	if signer != nil {
+		if err := signer(ctx, attemptReq); err != nil {
+			return nil, err
+		}
+	}
+
+	return attemptReq, nil
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
@@ -0,0 +1,172 @@
+package staxsdk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Run("retries idempotent requests and re-signs each retry", func(t *testing.T) {
+		assert := require.New(t)
+
+		var attempts int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempt := atomic.AddInt32(&attempts, 1)
+
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(`{"Name":"test"}`, string(body))
+
+			if attempt > 1 {
+				assert.Equal("signed", r.Header.Get("Authorization"))
+			}
+
+			if attempt < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+
+		var signed int32
+		httpClient := newTestRetryHttpClient(func(ctx context.Context, req *http.Request) error {
+			atomic.AddInt32(&signed, 1)
+			req.Header.Set("Authorization", "signed")
+			return nil
+		})
+
+		req, err := http.NewRequest(http.MethodPut, ts.URL, bytes.NewReader([]byte(`{"Name":"test"}`)))
+		assert.NoError(err)
+
+		res, err := httpClient.Do(req)
+		assert.NoError(err)
+		assert.Equal(http.StatusOK, res.StatusCode)
+		assert.Equal(int32(3), atomic.LoadInt32(&attempts))
+		assert.Equal(int32(2), atomic.LoadInt32(&signed))
+	})
+
+	t.Run("stops after max attempts", func(t *testing.T) {
+		assert := require.New(t)
+
+		var attempts int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer ts.Close()
+
+		res, err := newTestRetryHttpClient(nil).Get(ts.URL)
+		assert.NoError(err)
+		assert.Equal(http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(int32(3), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("does not retry non idempotent requests on server errors", func(t *testing.T) {
+		assert := require.New(t)
+
+		var attempts int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer ts.Close()
+
+		res, err := newTestRetryHttpClient(nil).Post(ts.URL, "application/json", bytes.NewReader([]byte(`{}`)))
+		assert.NoError(err)
+		assert.Equal(http.StatusBadGateway, res.StatusCode)
+		assert.Equal(int32(1), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("retries non idempotent requests when throttled", func(t *testing.T) {
+		assert := require.New(t)
+
+		var attempts int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+
+		res, err := newTestRetryHttpClient(nil).Post(ts.URL, "application/json", bytes.NewReader([]byte(`{}`)))
+		assert.NoError(err)
+		assert.Equal(http.StatusOK, res.StatusCode)
+		assert.Equal(int32(2), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("returns context error when cancelled while waiting", func(t *testing.T) {
+		assert := require.New(t)
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer ts.Close()
+
+		httpClient := &http.Client{
+			Transport: newRetryTransport(nil, RetryConfig{
+				MaxAttempts:          3,
+				BaseDelay:            time.Minute,
+				MaxDelay:             time.Minute,
+				RetryableStatusCodes: []int{http.StatusTooManyRequests},
+			}, nil),
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+		assert.NoError(err)
+
+		_, err = httpClient.Do(req)
+		assert.ErrorIs(err, context.DeadlineExceeded)
+	})
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	assert := require.New(t)
+
+	rt := newRetryTransport(nil, RetryConfig{
+		BaseDelay: time.Second,
+		MaxDelay:  5 * time.Second,
+		Jitter:    0.5,
+	}, nil)
+	rt.randFloat = func() float64 { return 1 }
+
+	assert.Equal(500*time.Millisecond, rt.backoff(1, nil))
+	assert.Equal(time.Second, rt.backoff(2, nil))
+	assert.Equal(2500*time.Millisecond, rt.backoff(4, nil))
+	assert.Equal(2500*time.Millisecond, rt.backoff(10, nil))
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(3*time.Second, rt.backoff(1, res))
+
+	res = &http.Response{Header: http.Header{"Retry-After": []string{"60"}}}
+	assert.Equal(5*time.Second, rt.backoff(1, res))
+}
+
+func newTestRetryHttpClient(signer client.RequestEditorFn) *http.Client {
+	return &http.Client{
+		Transport: newRetryTransport(nil, RetryConfig{
+			MaxAttempts:          3,
+			BaseDelay:            time.Millisecond,
+			MaxDelay:             5 * time.Millisecond,
+			Jitter:               0.5,
+			RetryableStatusCodes: DefaultRetryConfig().RetryableStatusCodes,
+		}, func() client.RequestEditorFn {
+			return signer
+		}),
+	}
+}
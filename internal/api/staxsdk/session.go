@@ -0,0 +1,217 @@
+package staxsdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+)
+
+// DefaultCredentialsRefreshWindow is how long before the temporary AWS credentials expire that they are refreshed.
+const DefaultCredentialsRefreshWindow = 5 * time.Minute
+
+// maxErrorBodySize limits how much of a 403 response body is read when checking for an expired token.
+const maxErrorBodySize = 64 * 1024
+
+// session holds the temporary AWS credentials used to sign requests, re-authenticating when they are about to expire.
+//
+// It is safe for concurrent use, concurrent callers which find the credentials expired share a single re-authentication.
+type session struct {
+	authenticate  func(ctx context.Context) (*auth.AuthResponse, error)
+	refreshWindow time.Duration
+	now           func() time.Time
+
+	// refreshMu serialises re-authentication, it is held while calling authenticate so mu is not held during network calls.
+	refreshMu sync.Mutex
+
+	mu           sync.RWMutex
+	region       string
+	credentials  aws.Credentials
+	tokensExpire time.Time
+	refreshFn    auth.RefreshFn
+	generation   uint64
+}
+
+func newSession(authenticate func(ctx context.Context) (*auth.AuthResponse, error), refreshWindow time.Duration) *session {
+	return &session{
+		authenticate:  authenticate,
+		refreshWindow: refreshWindow,
+		now:           time.Now,
+	}
+}
+
+// Region returns the AWS region of the credentials.
+func (s *session) Region() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.region
+}
+
+// Generation returns a counter which is incremented each time the credentials are refreshed.
+func (s *session) Generation() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.generation
+}
+
+// Retrieve returns the current credentials, re-authenticating first if they or the IDP tokens expire within the refresh window.
+func (s *session) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	s.mu.RLock()
+	creds, tokensExpire, generation := s.credentials, s.tokensExpire, s.generation
+	s.mu.RUnlock()
+
+	if generation > 0 && !s.expiring(creds, tokensExpire) {
+		return creds, nil
+	}
+
+	if err := s.Refresh(ctx, generation); err != nil {
+		return aws.Credentials{}, fmt.Errorf("failed to re-authenticate: %w", err)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.credentials, nil
+}
+
+// Refresh re-authenticates unless the credentials have already been refreshed since the provided generation was observed.
+//
+// The refresher returned with the last authentication is preferred, so the Cognito refresh token is used rather than
+// repeating the full authentication.
+func (s *session) Refresh(ctx context.Context, generation uint64) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	s.mu.RLock()
+	current, authenticate := s.generation, s.authenticate
+	if s.refreshFn != nil {
+		authenticate = s.refreshFn
+	}
+	s.mu.RUnlock()
+
+	if current != generation {
+		return nil
+	}
+
+	authResponse, err := authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	var creds aws.Credentials
+
+	if authResponse.AWSConfig.Credentials != nil {
+		creds, err = authResponse.AWSConfig.Credentials.Retrieve(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve credentials: %w", err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.region = authResponse.AWSConfig.Region
+	s.credentials = creds
+	s.tokensExpire = authResponse.TokensExpire
+	s.refreshFn = authResponse.Refresh
+	s.generation++
+
+	return nil
+}
+
+func (s *session) expiring(creds aws.Credentials, tokensExpire time.Time) bool {
+	refreshAt := s.now().Add(s.refreshWindow)
+
+	if !tokensExpire.IsZero() && !refreshAt.Before(tokensExpire) {
+		return true
+	}
+
+	return creds.CanExpire && !refreshAt.Before(creds.Expires)
+}
+
+// reauthTransport is a http.RoundTripper which re-authenticates and retries a request once when it is rejected
+// with a 403 because the signing credentials have expired.
+type reauthTransport struct {
+	next        http.RoundTripper
+	sessionFunc func() *session
+	signerFunc  func() client.RequestEditorFn
+}
+
+func newReauthTransport(next http.RoundTripper, sessionFunc func() *session, signerFunc func() client.RequestEditorFn) *reauthTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &reauthTransport{
+		next:        next,
+		sessionFunc: sessionFunc,
+		signerFunc:  signerFunc,
+	}
+}
+
+// RoundTrip executes the request, re-authenticating and retrying it if the credentials have expired.
+func (t *reauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// unsigned requests, such as those made while authenticating, are passed straight through
+	sess := t.sessionFunc()
+	if sess == nil || req.Header.Get("Authorization") == "" {
+		return t.next.RoundTrip(req)
+	}
+
+	generation := sess.Generation()
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil || !isExpiredTokenResponse(res) {
+		return res, err
+	}
+
+	// a request body which can't be replayed can't be retried
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return res, nil
+	}
+
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+
+	ctx := req.Context()
+
+	if err := sess.Refresh(ctx, generation); err != nil {
+		return nil, fmt.Errorf("failed to re-authenticate: %w", err)
+	}
+
+	retryReq, err := newSignedRequest(ctx, req, t.signerFunc())
+	if err != nil {
+		return nil, err
+	}
+
+	return t.next.RoundTrip(retryReq)
+}
+
+// isExpiredTokenResponse returns true if API Gateway rejected the request because the security token has expired,
+// the response body is restored so it can still be read by the caller.
+func isExpiredTokenResponse(res *http.Response) bool {
+	if res.StatusCode != http.StatusForbidden || res.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), res.Body), res.Body}
+
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(decodeErrorMessage(body)), "expired")
+}
@@ -0,0 +1,195 @@
+package staxsdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_Retrieve(t *testing.T) {
+	t.Run("re-authenticates when credentials are within the refresh window", func(t *testing.T) {
+		assert := require.New(t)
+
+		now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+
+		var authCount int32
+		sess := newSession(testSessionAuthFn(&authCount, func() time.Time { return now.Add(time.Hour) }), 5*time.Minute)
+		sess.now = func() time.Time { return now }
+
+		assert.NoError(sess.Refresh(context.TODO(), sess.Generation()))
+		assert.Equal(int32(1), atomic.LoadInt32(&authCount))
+
+		creds, err := sess.Retrieve(context.TODO())
+		assert.NoError(err)
+		assert.Equal("access-key-1", creds.AccessKeyID)
+		assert.Equal(int32(1), atomic.LoadInt32(&authCount))
+
+		now = now.Add(56 * time.Minute)
+
+		creds, err = sess.Retrieve(context.TODO())
+		assert.NoError(err)
+		assert.Equal("access-key-2", creds.AccessKeyID)
+		assert.Equal(int32(2), atomic.LoadInt32(&authCount))
+	})
+
+	t.Run("concurrent callers share a single re-authentication", func(t *testing.T) {
+		assert := require.New(t)
+
+		var authCount int32
+		sess := newSession(testSessionAuthFn(&authCount, func() time.Time { return time.Now().Add(time.Hour) }), 5*time.Minute)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := sess.Retrieve(context.TODO())
+				assert.NoError(err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(int32(1), atomic.LoadInt32(&authCount))
+	})
+}
+
+func TestSession_Refresh_UsesRefresher(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	var authCount, refreshCount int32
+	refreshAuthFn := testSessionAuthFn(&refreshCount, func() time.Time { return now.Add(2 * time.Hour) })
+
+	sess := newSession(func(ctx context.Context) (*auth.AuthResponse, error) {
+		atomic.AddInt32(&authCount, 1)
+
+		return &auth.AuthResponse{
+			TokensExpire: now.Add(time.Hour),
+			Refresh:      refreshAuthFn,
+		}, nil
+	}, 5*time.Minute)
+	sess.now = func() time.Time { return now }
+
+	assert.NoError(sess.Refresh(context.TODO(), sess.Generation()))
+
+	// the tokens expire within the refresh window even though the credentials never expire
+	now = now.Add(58 * time.Minute)
+
+	creds, err := sess.Retrieve(context.TODO())
+	assert.NoError(err)
+	assert.Equal("access-key-1", creds.AccessKeyID)
+	assert.Equal(int32(1), atomic.LoadInt32(&authCount))
+	assert.Equal(int32(1), atomic.LoadInt32(&refreshCount))
+}
+
+func TestReauthTransport(t *testing.T) {
+	assert := require.New(t)
+
+	var authCount int32
+	sess := newSession(testSessionAuthFn(&authCount, func() time.Time { return time.Now().Add(time.Hour) }), 5*time.Minute)
+	assert.NoError(sess.Refresh(context.TODO(), sess.Generation()))
+
+	signer := func(ctx context.Context, req *http.Request) error {
+		creds, err := sess.Retrieve(ctx)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Authorization", creds.AccessKeyID)
+		return nil
+	}
+
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+
+		if r.Header.Get("Authorization") == "access-key-1" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "The security token included in the request is expired"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	httpClient := &http.Client{
+		Transport: newReauthTransport(nil, func() *session { return sess }, func() client.RequestEditorFn { return signer }),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+	assert.NoError(err)
+	assert.NoError(signer(context.TODO(), req))
+
+	res, err := httpClient.Do(req)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, res.StatusCode)
+	assert.Equal(int32(2), atomic.LoadInt32(&attempts))
+	assert.Equal(int32(2), atomic.LoadInt32(&authCount))
+}
+
+func TestIsExpiredTokenResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       bool
+	}{
+		{name: "expired token", statusCode: http.StatusForbidden, body: `{"message": "The security token included in the request is expired"}`, want: true},
+		{name: "signature expired", statusCode: http.StatusForbidden, body: `{"message": "Signature expired: 20230801T120000Z is now earlier than 20230801T121000Z"}`, want: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, body: `{"message": "Forbidden"}`, want: false},
+		{name: "ok", statusCode: http.StatusOK, body: `{"message": "expired"}`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			rec := httptest.NewRecorder()
+			rec.WriteHeader(tt.statusCode)
+			_, _ = rec.WriteString(tt.body)
+			res := rec.Result()
+
+			assert.Equal(tt.want, isExpiredTokenResponse(res))
+
+			// the body must still be readable by the caller
+			body, err := io.ReadAll(res.Body)
+			assert.NoError(err)
+			assert.Equal(tt.body, string(body))
+		})
+	}
+}
+
+func testSessionAuthFn(authCount *int32, expires func() time.Time) func(ctx context.Context) (*auth.AuthResponse, error) {
+	return func(ctx context.Context) (*auth.AuthResponse, error) {
+		count := atomic.AddInt32(authCount, 1)
+
+		creds := aws.Credentials{
+			AccessKeyID:     fmt.Sprintf("access-key-%d", count),
+			SecretAccessKey: "secret-key",
+			SessionToken:    "session-token",
+			CanExpire:       true,
+			Expires:         expires(),
+		}
+
+		return &auth.AuthResponse{
+			AWSConfig: aws.Config{
+				Region: "ap-southeast-2",
+				Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+					return creds, nil
+				}),
+			},
+		}, nil
+	}
+}
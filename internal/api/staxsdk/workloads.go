@@ -0,0 +1,85 @@
+package staxsdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// workloadsResponse is the part of a workloads response which contains the workload outputs, the generated
+// models.Workload omits these fields.
+type workloadsResponse struct {
+	Workloads []workloadOutputs `json:"Workloads"`
+}
+
+type workloadOutputs struct {
+	Id      *string                    `json:"Id,omitempty"`
+	Outputs map[string]json.RawMessage `json:"Outputs,omitempty"`
+}
+
+//	WorkloadOutputs returns the outputs of the workloads in a workloads response, read from the response body.
+//
+// body: The body of the response, for example WorkloadsReadWorkloadsResp.Body.
+//
+// Returns:
+// - outputs: The outputs of each workload keyed by workload ID, values which aren't strings are JSON encoded.
+// - err: Any error decoding the body.
+func WorkloadOutputs(body []byte) (map[string]map[string]string, error) {
+	if len(body) == 0 {
+		return map[string]map[string]string{}, nil
+	}
+
+	res := new(workloadsResponse)
+
+	err := json.Unmarshal(body, res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode workloads response: %w", err)
+	}
+
+	outputs := make(map[string]map[string]string, len(res.Workloads))
+
+	for _, workload := range res.Workloads {
+		if workload.Id == nil {
+			continue
+		}
+
+		values := make(map[string]string, len(workload.Outputs))
+
+		for k, raw := range workload.Outputs {
+			var s string
+
+			// strings are returned as is, any other value is kept in its JSON encoded form
+			if err := json.Unmarshal(raw, &s); err == nil {
+				values[k] = s
+				continue
+			}
+
+			values[k] = string(raw)
+		}
+
+		outputs[*workload.Id] = values
+	}
+
+	return outputs, nil
+}
+
+// workloadsPage holds the undecoded workloads of a single page, these are merged into one body after all pages are read
+// so the outputs of every workload can be passed to WorkloadOutputs.
+type workloadsPage struct {
+	Workloads []json.RawMessage `json:"Workloads"`
+}
+
+func decodeWorkloadsPage(body []byte) ([]json.RawMessage, error) {
+	// an empty body is treated as an empty page
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	page := new(workloadsPage)
+
+	err := json.Unmarshal(body, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode workloads response: %w", err)
+	}
+
+	return page.Workloads, nil
+}
@@ -0,0 +1,50 @@
+package staxsdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadOutputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		outputs map[string]map[string]string
+	}{
+		{
+			name: "returns the outputs keyed by workload id",
+			body: `{"Workloads":[{"Id":"5b2b1a0e-7c1d-4f8e-9a4b-2c6d8e0f1a3b","Name":"bucket","Outputs":{"BucketName":"example-bucket","Retention":30}}]}`,
+			outputs: map[string]map[string]string{
+				"5b2b1a0e-7c1d-4f8e-9a4b-2c6d8e0f1a3b": {"BucketName": "example-bucket", "Retention": "30"},
+			},
+		},
+		{
+			name: "returns empty outputs for a workload without outputs",
+			body: `{"Workloads":[{"Id":"5b2b1a0e-7c1d-4f8e-9a4b-2c6d8e0f1a3b","Name":"bucket"}]}`,
+			outputs: map[string]map[string]string{
+				"5b2b1a0e-7c1d-4f8e-9a4b-2c6d8e0f1a3b": {},
+			},
+		},
+		{
+			name:    "returns no outputs for an empty body",
+			body:    ``,
+			outputs: map[string]map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			outputs, err := WorkloadOutputs([]byte(tt.body))
+			assert.NoError(err)
+			assert.Equal(tt.outputs, outputs)
+		})
+	}
+
+	t.Run("returns an error for an invalid body", func(t *testing.T) {
+		_, err := WorkloadOutputs([]byte("<html></html>"))
+		require.ErrorContains(t, err, "failed to decode workloads response")
+	})
+}
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,22 +18,33 @@ import (
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"golang.org/x/exp/slices"
 )
 
+// accountCloseLimits explains the AWS limits which commonly prevent accounts from being closed.
+const accountCloseLimits = "AWS limits when member accounts can be closed, for example there is a quota on the number of " +
+	"accounts which can be closed within a rolling 30 day period, and accounts must not have been created or moved " +
+	"recently. Check the account in the Stax console, and retry the destroy once the limit no longer applies."
+
+// accountClosedStatuses are the Stax account statuses of a closed account.
+var accountClosedStatuses = []models.AccountStatus{models.AccountStatusCLOSED, models.AccountStatusSUSPENDED}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AccountResource{}
 var _ resource.ResourceWithConfigure = &AccountResource{}
 var _ resource.ResourceWithImportState = &AccountResource{}
 
 type AccountResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Status          types.String `tfsdk:"status"`
-	AWsAccountID    types.String `tfsdk:"aws_account_id"`
-	AccountTypeID   types.String `tfsdk:"account_type_id"`
-	AccountType     types.String `tfsdk:"account_type"`
-	AwsAccountAlias types.String `tfsdk:"aws_account_alias"`
-	Tags            types.Map    `tfsdk:"tags"`
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Status          types.String   `tfsdk:"status"`
+	AWsAccountID    types.String   `tfsdk:"aws_account_id"`
+	AccountTypeID   types.String   `tfsdk:"account_type_id"`
+	AccountType     types.String   `tfsdk:"account_type"`
+	AwsAccountAlias types.String   `tfsdk:"aws_account_alias"`
+	Tags            types.Map      `tfsdk:"tags"`
+	CloseOnDestroy  types.Bool     `tfsdk:"close_on_destroy"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewAccountResource() resource.Resource {
@@ -91,6 +104,13 @@ func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"close_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Close the AWS account when this resource is destroyed, the account is suspended by AWS and can't be reopened through Stax. AWS limits how many member accounts can be closed within a rolling 30 day period. Defaults to `false`, which only removes the account from the Terraform state.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
 		},
 	}
 }
@@ -124,6 +144,13 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ac := models.AccountsCreateAccount_AccountType{}
 	err := ac.FromRoUuidv4(data.AccountTypeID.ValueString())
 	if err != nil {
@@ -150,7 +177,7 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 
 	taskResp, err := waitForTask(ctx, *created.JSON200.TaskId, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete task, got error: %s", err))
+		addTaskError(&resp.Diagnostics, "Unable to complete task", timeoutCreate, timeout, err)
 		return
 	}
 
@@ -186,6 +213,10 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	err := r.readAccount(ctx, data.ID.ValueString(), data)
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
 		return
@@ -205,6 +236,13 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	staxTags := make(map[string]string)
 	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
 
@@ -232,7 +270,7 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	_, err = waitForTask(ctx, *accountResp.JSON200.TaskId, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete task, got error: %s", err))
+		addTaskError(&resp.Diagnostics, "Unable to complete task", timeoutUpdate, timeout, err)
 		return
 	}
 
@@ -260,13 +298,90 @@ func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	resp.Diagnostics.AddWarning(
-		"Account Close Considerations",
-		"Applying this resource destruction will only remove the resource from the Terraform state "+
-			"and will not call the account close API due to AWS API limitations. Manually use the web "+
-			"interface to fully close this account.",
-	)
+	if !data.CloseOnDestroy.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Account Close Considerations",
+			"Applying this resource destruction will only remove the resource from the Terraform state "+
+				"and will not call the account close API due to AWS API limitations. Set close_on_destroy "+
+				"to true, or manually use the web interface to fully close this account.",
+		)
+
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "close account", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	closeResp, err := r.client.AccountClose(ctx, data.ID.ValueString())
+	if err != nil {
+		if staxsdk.IsBadRequest(err) || staxsdk.IsConflict(err) {
+			resp.Diagnostics.AddError(
+				"Account Close Rejected",
+				fmt.Sprintf("Stax rejected the request to close account %s, got error: %s\n\n%s", data.ID.ValueString(), err, accountCloseLimits),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to close account, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "account close response", map[string]interface{}{
+		"JSON200": closeResp.JSON200,
+	})
+
+	taskResp, err := r.client.MonitorTask(ctx, aws.ToString(closeResp.JSON200.TaskId), func(ctx context.Context, taskRes *client.TasksReadTaskResp) bool {
+		tflog.Debug(ctx, "read status of account close task", map[string]interface{}{
+			"status": taskRes.JSON200.Status,
+		})
+
+		return true
+	})
+	if err != nil {
+		addTaskError(&resp.Diagnostics, "Unable to complete account close task", timeoutDelete, timeout, err)
+		return
+	}
+
+	if taskResp.JSON200.Status != staxsdk.TaskSucceeded {
+		resp.Diagnostics.AddError(
+			"Account Close Failed",
+			fmt.Sprintf("The task to close account %s ended with status: %s, task logs:\n\n%s\n\n%s",
+				data.ID.ValueString(), taskResp.JSON200.Status, strings.Join(taskResp.JSON200.Logs, "\n"), accountCloseLimits),
+		)
+
+		return
+	}
+
+	// verify the account was closed, rather than trusting the task status alone
+	accountResp, err := r.client.AccountReadByID(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read closed account, got error: %s", err))
+		return
+	}
+
+	status := accountResp.JSON200.Accounts[0].Status
+	if status == nil || !slices.Contains(accountClosedStatuses, *status) {
+		resp.Diagnostics.AddError(
+			"Account Close Failed",
+			fmt.Sprintf("The task to close account %s succeeded, however the account status is: %s", data.ID.ValueString(), aws.ToString((*string)(status))),
+		)
 
+		return
+	}
+
+	tflog.Info(ctx, "account closed", map[string]interface{}{
+		"id":     data.ID.ValueString(),
+		"status": *status,
+	})
 }
 
 func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
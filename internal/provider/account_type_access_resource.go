@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountTypeAccessResource{}
+var _ resource.ResourceWithConfigure = &AccountTypeAccessResource{}
+var _ resource.ResourceWithImportState = &AccountTypeAccessResource{}
+
+type AccountTypeAccessResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	AccountTypeID types.String   `tfsdk:"account_type_id"`
+	RoleMappings  types.Set      `tfsdk:"role_mappings"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type AccountTypeRoleMappingModel struct {
+	GroupID  types.String `tfsdk:"group_id"`
+	RoleName types.String `tfsdk:"role_name"`
+}
+
+var accountTypeRoleMappingAttrTypes = map[string]attr.Type{
+	"group_id":  types.StringType,
+	"role_name": types.StringType,
+}
+
+func NewAccountTypeAccessResource() resource.Resource {
+	return &AccountTypeAccessResource{}
+}
+
+// AccountTypeAccessResource defines the resource implementation.
+type AccountTypeAccessResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *AccountTypeAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_type_access"
+}
+
+func (r *AccountTypeAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Stax Account Type Access resource, this authoritatively manages which Stax groups are granted AWS access roles in the accounts of an account type, any role mappings added outside of this resource are removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the account type",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_type_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the account type the role mappings apply to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_mappings": schema.SetNestedAttribute{
+				MarkdownDescription: "The mappings of Stax groups to AWS access roles for the account type",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the Stax group granted the role",
+							Required:            true,
+						},
+						"role_name": schema.StringAttribute{
+							MarkdownDescription: "The AWS access role granted to the group, this can be `admin`, `developer` or `readonly`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(models.Admin),
+									string(models.Developer),
+									string(models.Readonly),
+								),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *AccountTypeAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountTypeAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AccountTypeAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "adding role mappings to account type", map[string]interface{}{
+		"account_type_id": data.AccountTypeID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.updateAccountTypeAccess(ctx, data, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAccountTypeAccess(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountTypeAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AccountTypeAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(accountTypeAccessAPIToTFResource(ctx, accountTypeResp.JSON200.AccountTypes[0], data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountTypeAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData *AccountTypeAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, planData.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update account type role mappings", map[string]interface{}{
+		"account_type_id": planData.AccountTypeID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.updateAccountTypeAccess(ctx, planData, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAccountTypeAccess(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save planData into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *AccountTypeAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AccountTypeAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	roleMappings, diags := accountTypeRoleMappingKeys(ctx, data.RoleMappings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "removing role mappings from account type", map[string]interface{}{
+		"account_type_id": data.AccountTypeID.ValueString(),
+		"role_mappings":   roleMappings,
+	})
+
+	updateResp, err := r.client.AccountTypeUpdateAccess(ctx, models.AccountsUpdateAccountTypeAccess{
+		RemoveRoles: accountTypeAccessMap(data.AccountTypeID.ValueString(), roleMappings),
+	})
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove role mappings from account type, got error: %s", err))
+		return
+	}
+
+	if updateResp.JSON200 == nil || updateResp.JSON200.Detail.TaskId == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to remove role mappings from account type, the response did not contain a task id")
+		return
+	}
+
+	_, err = waitForTask(ctx, *updateResp.JSON200.Detail.TaskId, r.client)
+	if err != nil {
+		addTaskError(&resp.Diagnostics, "Unable to complete remove role mappings task", timeoutDelete, timeout, err)
+		return
+	}
+}
+
+func (r *AccountTypeAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_type_id"), req.ID)...)
+}
+
+// updateAccountTypeAccess adds the planned role mappings which don't exist on the account type and removes any
+// existing role mappings which aren't planned, then waits for the resulting task.
+func (r *AccountTypeAccessResource) updateAccountTypeAccess(ctx context.Context, data *AccountTypeAccessResourceModel, operation string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountTypeID := data.AccountTypeID.ValueString()
+
+	// get the current state from the API
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, accountTypeID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return diags
+	}
+
+	currentRoleMappings := make([]string, 0)
+	for _, role := range accountTypeResp.JSON200.AccountTypes[0].Roles {
+		currentRoleMappings = append(currentRoleMappings, accountTypeRoleMappingKey(aws.ToString(role.GroupId), role.RoleName))
+	}
+
+	planRoleMappings, d := accountTypeRoleMappingKeys(ctx, data.RoleMappings)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	addedRoleMappings := helpers.Subtract(helpers.ToSet(planRoleMappings), helpers.ToSet(currentRoleMappings))
+	removedRoleMappings := helpers.Subtract(helpers.ToSet(currentRoleMappings), helpers.ToSet(planRoleMappings))
+
+	tflog.Info(ctx, "account type role mappings update", map[string]interface{}{
+		"added":   addedRoleMappings,
+		"removed": removedRoleMappings,
+	})
+
+	if len(addedRoleMappings) == 0 && len(removedRoleMappings) == 0 {
+		return diags
+	}
+
+	updateResp, err := r.client.AccountTypeUpdateAccess(ctx, models.AccountsUpdateAccountTypeAccess{
+		AddRoles:    accountTypeAccessMap(accountTypeID, addedRoleMappings),
+		RemoveRoles: accountTypeAccessMap(accountTypeID, removedRoleMappings),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update account type role mappings, got error: %s", err))
+		return diags
+	}
+
+	if updateResp.JSON200 == nil || updateResp.JSON200.Detail.TaskId == nil {
+		diags.AddError("Client Error", "Unable to update account type role mappings, the response did not contain a task id")
+		return diags
+	}
+
+	taskResp, err := waitForTask(ctx, *updateResp.JSON200.Detail.TaskId, r.client)
+	if err != nil {
+		addTaskError(&diags, "Unable to complete update account type role mappings task", operation, timeout, err)
+		return diags
+	}
+
+	tflog.Debug(ctx, "update account type role mappings task response", map[string]interface{}{
+		"JSON200": taskResp,
+	})
+
+	return diags
+}
+
+func (r *AccountTypeAccessResource) readAccountTypeAccess(ctx context.Context, data *AccountTypeAccessResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, data.AccountTypeID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return diags
+	}
+
+	return accountTypeAccessAPIToTFResource(ctx, accountTypeResp.JSON200.AccountTypes[0], data)
+}
+
+func accountTypeAccessAPIToTFResource(ctx context.Context, accountType models.AccountType, data *AccountTypeAccessResourceModel) diag.Diagnostics {
+	roleMappings := make([]AccountTypeRoleMappingModel, 0, len(accountType.Roles))
+
+	for _, role := range accountType.Roles {
+		roleMappings = append(roleMappings, AccountTypeRoleMappingModel{
+			GroupID:  types.StringPointerValue(role.GroupId),
+			RoleName: types.StringValue(role.RoleName),
+		})
+	}
+
+	roleMappingsSet, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: accountTypeRoleMappingAttrTypes}, roleMappings)
+
+	data.ID = types.StringValue(aws.ToString(accountType.Id))
+	data.AccountTypeID = types.StringValue(aws.ToString(accountType.Id))
+	data.RoleMappings = roleMappingsSet
+
+	return diags
+}
+
+// accountTypeRoleMappingKey returns a key identifying a role mapping, this is used to compare the planned and current
+// role mappings with helpers.Subtract.
+func accountTypeRoleMappingKey(groupID, roleName string) string {
+	return groupID + "/" + roleName
+}
+
+func accountTypeRoleMappingKeys(ctx context.Context, roleMappings types.Set) ([]string, diag.Diagnostics) {
+	var mappings []AccountTypeRoleMappingModel
+
+	diags := roleMappings.ElementsAs(ctx, &mappings, false)
+
+	keys := make([]string, 0, len(mappings))
+	for _, roleMapping := range mappings {
+		keys = append(keys, accountTypeRoleMappingKey(roleMapping.GroupID.ValueString(), roleMapping.RoleName.ValueString()))
+	}
+
+	return keys, diags
+}
+
+func accountTypeAccessMap(accountTypeID string, roleMappingKeys []string) *[]models.AccountTypeAccessMap {
+	if len(roleMappingKeys) == 0 {
+		return nil
+	}
+
+	accessMap := []models.AccountTypeAccessMap{}
+
+	for _, key := range roleMappingKeys {
+		groupID, roleName, _ := strings.Cut(key, "/")
+
+		accessMap = append(accessMap, models.AccountTypeAccessMap{
+			AccountTypeId: aws.String(accountTypeID),
+			GroupId:       aws.String(groupID),
+			RoleName:      (*models.AccountTypeAccessMapRoleName)(aws.String(roleName)),
+		})
+	}
+
+	return &accessMap
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasttemplate"
+)
+
+type accountTypeRole = struct {
+	GroupId  *models.RoUuidv4 `json:"GroupId,omitempty"`
+	RoleName string           `json:"RoleName"`
+}
+
+func TestAccountTypeAccessResource(t *testing.T) {
+
+	accountTypeID := "3b7f3e2a-52a4-4b1b-9d0b-1c5f6ad3c0e2"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+	groupID := "87c570e2-c795-44b0-aefa-ebdcffd4d048"
+
+	// the role mappings of the account type, updated by the mock API
+	roles := []accountTypeRole{}
+
+	si := mocks.NewServerInterface(t)
+
+	updateAccessEvent := fmt.Sprintf(`{"DetailType":"stax.account_type.access.update","Detail":{"Message":"update started","Operation":"UPDATE","OperationStatus":"STARTED","Severity":"INFO","TaskId":"%s"}}`, taskID)
+
+	si.On("AccountsUpdateAccountTypeAccess", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		update := new(models.AccountsUpdateAccountTypeAccess)
+		if err := c.Bind(update); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if update.AddRoles == nil || len(*update.AddRoles) != 1 {
+			t.Fatalf("expected one role mapping to add")
+		}
+
+		if update.RemoveRoles != nil {
+			t.Errorf("role mappings to remove not expected: %v", *update.RemoveRoles)
+		}
+
+		added := (*update.AddRoles)[0]
+		roles = []accountTypeRole{{GroupId: added.GroupId, RoleName: string(*added.RoleName)}}
+
+		return c.JSONBlob(200, []byte(updateAccessEvent))
+	}).Once()
+
+	si.On("AccountsUpdateAccountTypeAccess", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		update := new(models.AccountsUpdateAccountTypeAccess)
+		if err := c.Bind(update); err != nil {
+			t.Errorf("failed to bind request: %s", err)
+		}
+
+		if update.AddRoles != nil {
+			t.Errorf("role mappings to add not expected: %v", *update.AddRoles)
+		}
+
+		if update.RemoveRoles == nil {
+			t.Errorf("no role mappings to remove")
+		}
+
+		roles = []accountTypeRole{}
+
+		return c.JSONBlob(200, []byte(updateAccessEvent))
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("AccountsReadAccountType", mock.AnythingOfType("*echo.context"), accountTypeID, mock.AnythingOfType("models.AccountsReadAccountTypeParams")).Return(func(c echo.Context, accountTypeID string, params models.AccountsReadAccountTypeParams) error {
+		return c.JSON(200, &models.AccountsReadAccountTypes{
+			AccountTypes: []models.AccountType{
+				{
+					Id:    aws.String(accountTypeID),
+					Name:  "production",
+					Roles: roles,
+				},
+			},
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxAccountTypeAccessConfig("production", accountTypeID, groupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_account_type_access.production", "id", accountTypeID),
+					resource.TestCheckResourceAttr("stax_account_type_access.production", "role_mappings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStaxAccountTypeAccessConfig(label, accountTypeID, groupID string) string {
+	configTemplate := `
+resource "stax_account_type_access" "${label}" {
+	account_type_id = "${account_type_id}"
+	role_mappings = [
+		{
+			group_id  = "${group_id}"
+			role_name = "readonly"
+		}
+	]
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":           label,
+			"account_type_id": accountTypeID,
+			"group_id":        groupID,
+		},
+	)
+}
+
+func TestAccountTypeAccessResource_updateAccountTypeAccess(t *testing.T) {
+	accountTypeID := "3b7f3e2a-52a4-4b1b-9d0b-1c5f6ad3c0e2"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+	adminGroupID := "0a5c1d38-9a51-4c6b-8d2e-3f4a5b6c7d8e"
+	developerGroupID := "7d2e3f4a-5b6c-4d8e-9f0a-1b2c3d4e5f6a"
+
+	accessData := func(t *testing.T, roleMappings ...AccountTypeRoleMappingModel) *AccountTypeAccessResourceModel {
+		roleMappingsSet, diags := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: accountTypeRoleMappingAttrTypes}, roleMappings)
+		require.False(t, diags.HasError())
+
+		return &AccountTypeAccessResourceModel{
+			AccountTypeID: types.StringValue(accountTypeID),
+			RoleMappings:  roleMappingsSet,
+		}
+	}
+
+	accessMap := func(groupID, roleName string) *[]models.AccountTypeAccessMap {
+		return &[]models.AccountTypeAccessMap{
+			{
+				AccountTypeId: aws.String(accountTypeID),
+				GroupId:       aws.String(groupID),
+				RoleName:      (*models.AccountTypeAccessMapRoleName)(aws.String(roleName)),
+			},
+		}
+	}
+
+	t.Run("adds and removes the changed role mappings", func(t *testing.T) {
+		clientMock := newClientMock(t)
+
+		clientMock.onAccountTypeRead(models.AccountType{
+			Id: aws.String(accountTypeID),
+			Roles: []accountTypeRole{
+				{GroupId: aws.String(adminGroupID), RoleName: "AdminRole"},
+				{GroupId: aws.String(developerGroupID), RoleName: "DeveloperRole"},
+			},
+		})
+
+		updateEvent := &models.AccountsUpdateAccountTypeAccessEvent{}
+		updateEvent.Detail.TaskId = aws.String(taskID)
+
+		clientMock.On("AccountTypeUpdateAccess", mock.Anything, models.AccountsUpdateAccountTypeAccess{
+			AddRoles:    accessMap(developerGroupID, "ReadOnlyRole"),
+			RemoveRoles: accessMap(developerGroupID, "DeveloperRole"),
+		}).Return(&client.AccountsUpdateAccountTypeAccessResp{
+			JSON200: updateEvent,
+		}, nil).Once()
+
+		clientMock.onTaskSucceeded(taskID)
+
+		r := &AccountTypeAccessResource{client: clientMock}
+
+		diags := r.updateAccountTypeAccess(context.Background(), accessData(t,
+			AccountTypeRoleMappingModel{GroupID: types.StringValue(adminGroupID), RoleName: types.StringValue("AdminRole")},
+			AccountTypeRoleMappingModel{GroupID: types.StringValue(developerGroupID), RoleName: types.StringValue("ReadOnlyRole")},
+		), timeoutUpdate, 0)
+		require.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("does not update unchanged role mappings", func(t *testing.T) {
+		clientMock := newClientMock(t)
+
+		clientMock.onAccountTypeRead(models.AccountType{
+			Id:    aws.String(accountTypeID),
+			Roles: []accountTypeRole{{GroupId: aws.String(adminGroupID), RoleName: "AdminRole"}},
+		})
+
+		r := &AccountTypeAccessResource{client: clientMock}
+
+		diags := r.updateAccountTypeAccess(context.Background(), accessData(t,
+			AccountTypeRoleMappingModel{GroupID: types.StringValue(adminGroupID), RoleName: types.StringValue("AdminRole")},
+		), timeoutUpdate, 0)
+		require.False(t, diags.HasError(), diags)
+	})
+}
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountTypeMembersResource{}
+var _ resource.ResourceWithConfigure = &AccountTypeMembersResource{}
+var _ resource.ResourceWithImportState = &AccountTypeMembersResource{}
+
+type AccountTypeMembersResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	AccountTypeID         types.String   `tfsdk:"account_type_id"`
+	AccountIDs            types.Set      `tfsdk:"account_ids"`
+	FallbackAccountTypeID types.String   `tfsdk:"fallback_account_type_id"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAccountTypeMembersResource() resource.Resource {
+	return &AccountTypeMembersResource{}
+}
+
+// AccountTypeMembersResource defines the resource implementation.
+type AccountTypeMembersResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *AccountTypeMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_type_members"
+}
+
+func (r *AccountTypeMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Stax Account Type Members resource, this authoritatively manages which accounts belong to an account type, " +
+			"including accounts which are not managed by Terraform, all accounts are moved by a single Stax task. " +
+			"Accounts listed here should not also set `account_type_id` on a `stax_account` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the account type",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_type_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the account type the accounts belong to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_ids": schema.SetAttribute{
+				MarkdownDescription: "The identifiers of the Stax accounts which belong to the account type",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"fallback_account_type_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the account type accounts are moved to when they are removed from `account_ids`, " +
+					"or when this resource is destroyed. Every account belongs to an account type, so when this isn't set removing " +
+					"an account is an error and destroying this resource leaves the accounts in the account type.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *AccountTypeMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountTypeMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AccountTypeMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "moving accounts to account type", map[string]interface{}{
+		"account_type_id": data.AccountTypeID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.updateAccountTypeMembers(ctx, data, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAccountTypeMembers(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountTypeMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AccountTypeMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(accountTypeMembersAPIToTFResource(ctx, accountTypeResp.JSON200.AccountTypes[0], data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountTypeMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData *AccountTypeMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, planData.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update account type members", map[string]interface{}{
+		"account_type_id": planData.AccountTypeID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.updateAccountTypeMembers(ctx, planData, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAccountTypeMembers(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save planData into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *AccountTypeMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AccountTypeMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FallbackAccountTypeID.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Accounts Not Moved",
+			fmt.Sprintf("The accounts remain in account type %s as fallback_account_type_id is not set.", data.AccountTypeID.ValueString()),
+		)
+
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	var accountIDs []string
+	resp.Diagnostics.Append(data.AccountIDs.ElementsAs(ctx, &accountIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "moving accounts to fallback account type", map[string]interface{}{
+		"account_type_id":          data.AccountTypeID.ValueString(),
+		"fallback_account_type_id": data.FallbackAccountTypeID.ValueString(),
+		"account_ids":              accountIDs,
+	})
+
+	resp.Diagnostics.Append(r.moveAccounts(ctx, accountTypeMemberMap(data.FallbackAccountTypeID.ValueString(), accountIDs), timeoutDelete, timeout)...)
+}
+
+func (r *AccountTypeMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_type_id"), req.ID)...)
+}
+
+// updateAccountTypeMembers moves the planned accounts which aren't in the account type into it, and moves any accounts
+// in the account type which aren't planned to the fallback account type, then waits for the resulting task.
+func (r *AccountTypeMembersResource) updateAccountTypeMembers(ctx context.Context, data *AccountTypeMembersResourceModel, operation string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountTypeID := data.AccountTypeID.ValueString()
+
+	// get the current state from the API
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, accountTypeID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return diags
+	}
+
+	currentAccounts := helpers.ToSet(accountTypeResp.JSON200.AccountTypes[0].Accounts)
+
+	accountIDs := make([]string, 0)
+	diags.Append(data.AccountIDs.ElementsAs(ctx, &accountIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	planAccounts := helpers.ToSet(accountIDs)
+
+	addedAccountIDs := helpers.Subtract(planAccounts, currentAccounts)
+	removedAccountIDs := helpers.Subtract(currentAccounts, planAccounts)
+
+	tflog.Info(ctx, "account type members update", map[string]interface{}{
+		"added":   addedAccountIDs,
+		"removed": removedAccountIDs,
+	})
+
+	if len(removedAccountIDs) > 0 && data.FallbackAccountTypeID.IsNull() {
+		diags.AddAttributeError(
+			path.Root("account_ids"),
+			"Unable to Remove Accounts",
+			fmt.Sprintf("Accounts %s belong to account type %s but are not in account_ids. Every account belongs to an account type, "+
+				"add the accounts to account_ids or set fallback_account_type_id to the account type they should be moved to.",
+				strings.Join(removedAccountIDs, ", "), accountTypeID),
+		)
+
+		return diags
+	}
+
+	members := accountTypeMemberMap(accountTypeID, addedAccountIDs)
+	members = append(members, accountTypeMemberMap(data.FallbackAccountTypeID.ValueString(), removedAccountIDs)...)
+
+	if len(members) == 0 {
+		return diags
+	}
+
+	return r.moveAccounts(ctx, members, operation, timeout)
+}
+
+func (r *AccountTypeMembersResource) moveAccounts(ctx context.Context, members []models.AccountTypeMemberMap, operation string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	updateResp, err := r.client.AccountTypeUpdateMembers(ctx, models.AccountsUpdateAccountTypeMembers{
+		Members: members,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to move accounts to account type, got error: %s", err))
+		return diags
+	}
+
+	if updateResp.JSON200 == nil || updateResp.JSON200.Detail.TaskId == nil {
+		diags.AddError("Client Error", "Unable to move accounts to account type, the response did not contain a task id")
+		return diags
+	}
+
+	taskResp, err := waitForTask(ctx, *updateResp.JSON200.Detail.TaskId, r.client)
+	if err != nil {
+		addTaskError(&diags, "Unable to complete move accounts task", operation, timeout, err)
+		return diags
+	}
+
+	tflog.Debug(ctx, "move accounts task response", map[string]interface{}{
+		"JSON200": taskResp,
+	})
+
+	return diags
+}
+
+func (r *AccountTypeMembersResource) readAccountTypeMembers(ctx context.Context, data *AccountTypeMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, data.AccountTypeID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return diags
+	}
+
+	return accountTypeMembersAPIToTFResource(ctx, accountTypeResp.JSON200.AccountTypes[0], data)
+}
+
+func accountTypeMembersAPIToTFResource(ctx context.Context, accountType models.AccountType, data *AccountTypeMembersResourceModel) diag.Diagnostics {
+	accountIDs := append([]string{}, accountType.Accounts...)
+
+	accountsSet, diags := types.SetValueFrom(ctx, types.StringType, accountIDs)
+
+	data.ID = types.StringValue(aws.ToString(accountType.Id))
+	data.AccountTypeID = types.StringValue(aws.ToString(accountType.Id))
+	data.AccountIDs = accountsSet
+
+	return diags
+}
+
+func accountTypeMemberMap(accountTypeID string, accountIDs []string) []models.AccountTypeMemberMap {
+	members := []models.AccountTypeMemberMap{}
+
+	for _, accountID := range accountIDs {
+		members = append(members, models.AccountTypeMemberMap{
+			AccountId:     aws.String(accountID),
+			AccountTypeId: aws.String(accountTypeID),
+		})
+	}
+
+	return members
+}
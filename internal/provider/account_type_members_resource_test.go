@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestAccountTypeMembersResource(t *testing.T) {
+
+	accountTypeID := "3b7f3e2a-52a4-4b1b-9d0b-1c5f6ad3c0e2"
+	fallbackAccountTypeID := "a1c6e0a4-8d1e-4f0c-9a53-1f4e8b2d7c61"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+	accountID := "6a1b9f3e-4e7d-4c1a-8b5e-2f9d0c3a7e14"
+	unmanagedAccountID := "0f5e2d7c-9b3a-4d6e-a1c8-7e4b2f9d5a30"
+
+	// the accounts in the account type, updated by the mock API
+	accounts := []string{unmanagedAccountID}
+
+	si := mocks.NewServerInterface(t)
+
+	updateMembersEvent := fmt.Sprintf(`{"DetailType":"stax.account_type.members.update","Detail":{"Message":"update started","Operation":"UPDATE","OperationStatus":"STARTED","Severity":"INFO","TaskId":"%s"}}`, taskID)
+
+	si.On("AccountsUpdateAccountTypeMembers", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		update := new(models.AccountsUpdateAccountTypeMembers)
+		if err := c.Bind(update); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if len(update.Members) != 2 {
+			t.Fatalf("expected two accounts to move, got: %d", len(update.Members))
+		}
+
+		for _, member := range update.Members {
+			switch aws.ToString(member.AccountId) {
+			case accountID:
+				if aws.ToString(member.AccountTypeId) != accountTypeID {
+					t.Errorf("expected account to move to the account type, got: %s", aws.ToString(member.AccountTypeId))
+				}
+			case unmanagedAccountID:
+				if aws.ToString(member.AccountTypeId) != fallbackAccountTypeID {
+					t.Errorf("expected unmanaged account to move to the fallback account type, got: %s", aws.ToString(member.AccountTypeId))
+				}
+			default:
+				t.Errorf("unexpected account: %s", aws.ToString(member.AccountId))
+			}
+		}
+
+		accounts = []string{accountID}
+
+		return c.JSONBlob(200, []byte(updateMembersEvent))
+	}).Once()
+
+	si.On("AccountsUpdateAccountTypeMembers", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		update := new(models.AccountsUpdateAccountTypeMembers)
+		if err := c.Bind(update); err != nil {
+			t.Errorf("failed to bind request: %s", err)
+		}
+
+		if len(update.Members) != 1 || aws.ToString(update.Members[0].AccountTypeId) != fallbackAccountTypeID {
+			t.Errorf("expected one account to move to the fallback account type: %v", update.Members)
+		}
+
+		accounts = []string{}
+
+		return c.JSONBlob(200, []byte(updateMembersEvent))
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("AccountsReadAccountType", mock.AnythingOfType("*echo.context"), accountTypeID, mock.AnythingOfType("models.AccountsReadAccountTypeParams")).Return(func(c echo.Context, accountTypeID string, params models.AccountsReadAccountTypeParams) error {
+		return c.JSON(200, &models.AccountsReadAccountTypes{
+			AccountTypes: []models.AccountType{
+				{
+					Id:       aws.String(accountTypeID),
+					Name:     "production",
+					Accounts: accounts,
+				},
+			},
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxAccountTypeMembersConfig("production", accountTypeID, fallbackAccountTypeID, accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_account_type_members.production", "id", accountTypeID),
+					resource.TestCheckResourceAttr("stax_account_type_members.production", "account_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStaxAccountTypeMembersConfig(label, accountTypeID, fallbackAccountTypeID, accountID string) string {
+	configTemplate := `
+resource "stax_account_type_members" "${label}" {
+	account_type_id          = "${account_type_id}"
+	fallback_account_type_id = "${fallback_account_type_id}"
+	account_ids = [
+		"${account_id}"
+	]
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":                    label,
+			"account_type_id":          accountTypeID,
+			"fallback_account_type_id": fallbackAccountTypeID,
+			"account_id":               accountID,
+		},
+	)
+}
+
+func TestAccountTypeMembersResource_updateAccountTypeMembers(t *testing.T) {
+	accountTypeID := "3b7f3e2a-52a4-4b1b-9d0b-1c5f6ad3c0e2"
+	fallbackAccountTypeID := "9c8b7a6f-5e4d-4c3b-8a2f-1e0d9c8b7a6f"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	membersData := func(t *testing.T, fallbackAccountTypeID types.String, accountIDs ...string) *AccountTypeMembersResourceModel {
+		accountIDsSet, diags := types.SetValueFrom(context.Background(), types.StringType, accountIDs)
+		require.False(t, diags.HasError())
+
+		return &AccountTypeMembersResourceModel{
+			AccountTypeID:         types.StringValue(accountTypeID),
+			AccountIDs:            accountIDsSet,
+			FallbackAccountTypeID: fallbackAccountTypeID,
+		}
+	}
+
+	t.Run("moves added accounts in and removed accounts to the fallback account type", func(t *testing.T) {
+		clientMock := newClientMock(t)
+
+		clientMock.onAccountTypeRead(models.AccountType{Id: aws.String(accountTypeID), Accounts: []string{"account-1", "account-2"}})
+
+		updateEvent := &models.AccountsUpdateAccountTypeMembersEvent{}
+		updateEvent.Detail.TaskId = aws.String(taskID)
+
+		clientMock.On("AccountTypeUpdateMembers", mock.Anything, models.AccountsUpdateAccountTypeMembers{
+			Members: []models.AccountTypeMemberMap{
+				{AccountId: aws.String("account-3"), AccountTypeId: aws.String(accountTypeID)},
+				{AccountId: aws.String("account-1"), AccountTypeId: aws.String(fallbackAccountTypeID)},
+			},
+		}).Return(&client.AccountsUpdateAccountTypeMembersResp{
+			JSON200: updateEvent,
+		}, nil).Once()
+
+		clientMock.onTaskSucceeded(taskID)
+
+		r := &AccountTypeMembersResource{client: clientMock}
+
+		diags := r.updateAccountTypeMembers(context.Background(), membersData(t, types.StringValue(fallbackAccountTypeID), "account-2", "account-3"), timeoutUpdate, 0)
+		require.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("requires a fallback account type to remove accounts", func(t *testing.T) {
+		clientMock := newClientMock(t)
+
+		clientMock.onAccountTypeRead(models.AccountType{Id: aws.String(accountTypeID), Accounts: []string{"account-1", "account-2"}})
+
+		r := &AccountTypeMembersResource{client: clientMock}
+
+		diags := r.updateAccountTypeMembers(context.Background(), membersData(t, types.StringNull(), "account-2"), timeoutUpdate, 0)
+		require.True(t, diags.HasError())
+		require.Equal(t, "Unable to Remove Accounts", diags[0].Summary())
+	})
+}
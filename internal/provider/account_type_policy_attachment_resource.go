@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountTypePolicyAttachmentResource{}
+var _ resource.ResourceWithConfigure = &AccountTypePolicyAttachmentResource{}
+var _ resource.ResourceWithImportState = &AccountTypePolicyAttachmentResource{}
+
+type AccountTypePolicyAttachmentResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	AccountTypeID types.String   `tfsdk:"account_type_id"`
+	PolicyIDs     types.Set      `tfsdk:"policy_ids"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAccountTypePolicyAttachmentResource() resource.Resource {
+	return &AccountTypePolicyAttachmentResource{}
+}
+
+// AccountTypePolicyAttachmentResource defines the resource implementation.
+type AccountTypePolicyAttachmentResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *AccountTypePolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_type_policy_attachment"
+}
+
+func (r *AccountTypePolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Stax Account Type Policy Attachment resource, this authoritatively manages the policies attached to an account type, any policies attached outside of this resource are detached.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the account type",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_type_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the account type the policies are attached to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_ids": schema.SetAttribute{
+				MarkdownDescription: "The identifiers of the Stax policies attached to the account type",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *AccountTypePolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountTypePolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AccountTypePolicyAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "attaching policies to account type", map[string]interface{}{
+		"account_type_id": data.AccountTypeID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.updateAccountTypePolicies(ctx, data, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAccountTypePolicies(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountTypePolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AccountTypePolicyAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(accountTypePoliciesAPIToTFResource(ctx, accountTypeResp.JSON200.AccountTypes[0].Id, accountTypeResp.JSON200.AccountTypes[0].Policies, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountTypePolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData *AccountTypePolicyAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, planData.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update account type policies", map[string]interface{}{
+		"account_type_id": planData.AccountTypeID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.updateAccountTypePolicies(ctx, planData, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAccountTypePolicies(ctx, planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save planData into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *AccountTypePolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AccountTypePolicyAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	var removePolicyIDs []string
+	resp.Diagnostics.Append(data.PolicyIDs.ElementsAs(ctx, &removePolicyIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "detaching policies from account type", map[string]interface{}{
+		"account_type_id": data.AccountTypeID.ValueString(),
+		"policy_ids":      removePolicyIDs,
+	})
+
+	updateResp, err := r.client.AccountTypeUpdatePolicies(ctx, data.AccountTypeID.ValueString(), nil, removePolicyIDs)
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach policies from account type, got error: %s", err))
+		return
+	}
+
+	if updateResp.JSON200 == nil || updateResp.JSON200.Detail.TaskId == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to detach policies from account type, the response did not contain a task id")
+		return
+	}
+
+	_, err = waitForTask(ctx, *updateResp.JSON200.Detail.TaskId, r.client)
+	if err != nil {
+		addTaskError(&resp.Diagnostics, "Unable to complete detach policies task", timeoutDelete, timeout, err)
+		return
+	}
+}
+
+func (r *AccountTypePolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_type_id"), req.ID)...)
+}
+
+// updateAccountTypePolicies attaches the planned policies which aren't attached to the account type and detaches any
+// attached policies which aren't planned, then waits for the resulting task.
+func (r *AccountTypePolicyAttachmentResource) updateAccountTypePolicies(ctx context.Context, data *AccountTypePolicyAttachmentResourceModel, operation string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountTypeID := data.AccountTypeID.ValueString()
+
+	// get the current state from the API
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, accountTypeID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return diags
+	}
+
+	currentPolicies := helpers.ToSet(accountTypeResp.JSON200.AccountTypes[0].Policies)
+
+	policyIDs := make([]string, 0)
+	diags.Append(data.PolicyIDs.ElementsAs(ctx, &policyIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	planPolicies := helpers.ToSet(policyIDs)
+
+	addedPolicyIDs := helpers.Subtract(planPolicies, currentPolicies)
+	removedPolicyIDs := helpers.Subtract(currentPolicies, planPolicies)
+
+	tflog.Info(ctx, "account type policies update", map[string]interface{}{
+		"added":   addedPolicyIDs,
+		"removed": removedPolicyIDs,
+	})
+
+	if len(addedPolicyIDs) == 0 && len(removedPolicyIDs) == 0 {
+		return diags
+	}
+
+	updateResp, err := r.client.AccountTypeUpdatePolicies(ctx, accountTypeID, addedPolicyIDs, removedPolicyIDs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update account type policies, got error: %s", err))
+		return diags
+	}
+
+	if updateResp.JSON200 == nil || updateResp.JSON200.Detail.TaskId == nil {
+		diags.AddError("Client Error", "Unable to update account type policies, the response did not contain a task id")
+		return diags
+	}
+
+	taskResp, err := waitForTask(ctx, *updateResp.JSON200.Detail.TaskId, r.client)
+	if err != nil {
+		addTaskError(&diags, "Unable to complete update account type policies task", operation, timeout, err)
+		return diags
+	}
+
+	tflog.Debug(ctx, "update account type policies task response", map[string]interface{}{
+		"JSON200": taskResp,
+	})
+
+	return diags
+}
+
+func (r *AccountTypePolicyAttachmentResource) readAccountTypePolicies(ctx context.Context, data *AccountTypePolicyAttachmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountTypeResp, err := r.client.AccountTypeReadById(ctx, data.AccountTypeID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
+		return diags
+	}
+
+	accountType := accountTypeResp.JSON200.AccountTypes[0]
+
+	return accountTypePoliciesAPIToTFResource(ctx, accountType.Id, accountType.Policies, data)
+}
+
+func accountTypePoliciesAPIToTFResource(ctx context.Context, accountTypeID *string, policies []string, data *AccountTypePolicyAttachmentResourceModel) diag.Diagnostics {
+	policyIDs := append([]string{}, policies...)
+	slices.Sort(policyIDs)
+
+	policiesSet, diags := types.SetValueFrom(ctx, types.StringType, policyIDs)
+
+	data.ID = types.StringValue(aws.ToString(accountTypeID))
+	data.AccountTypeID = types.StringValue(aws.ToString(accountTypeID))
+	data.PolicyIDs = policiesSet
+
+	return diags
+}
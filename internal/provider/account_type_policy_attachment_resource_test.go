@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestAccountTypePolicyAttachmentResource(t *testing.T) {
+
+	accountTypeID := "3b7f3e2a-52a4-4b1b-9d0b-1c5f6ad3c0e2"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+	policyID := "6e7c1f9a-0d3b-4c8e-9f2a-5b4d3c2a1e0f"
+
+	// the policies attached to the account type, updated by the mock API
+	policies := []string{}
+
+	si := mocks.NewServerInterface(t)
+
+	updatePoliciesEvent := fmt.Sprintf(`{"DetailType":"stax.account_type.policies.update","Detail":{"Message":"update started","Operation":"UPDATE","OperationStatus":"STARTED","Severity":"INFO","TaskId":"%s"}}`, taskID)
+
+	si.On("AccountsUpdateAccountTypePolicies", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		update := new(models.AccountsUpdateAccountTypePolicies)
+		if err := c.Bind(update); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if update.AddPolicies == nil {
+			t.Errorf("no policies to attach")
+		}
+
+		if update.RemovePolicies != nil {
+			t.Errorf("policies to detach not expected: %v", *update.RemovePolicies)
+		}
+
+		policies = []string{policyID}
+
+		return c.JSONBlob(200, []byte(updatePoliciesEvent))
+	}).Once()
+
+	si.On("AccountsUpdateAccountTypePolicies", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		update := new(models.AccountsUpdateAccountTypePolicies)
+		if err := c.Bind(update); err != nil {
+			t.Errorf("failed to bind request: %s", err)
+		}
+
+		if update.AddPolicies != nil {
+			t.Errorf("policies to attach not expected: %v", *update.AddPolicies)
+		}
+
+		if update.RemovePolicies == nil {
+			t.Errorf("no policies to detach")
+		}
+
+		policies = []string{}
+
+		return c.JSONBlob(200, []byte(updatePoliciesEvent))
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("AccountsReadAccountType", mock.AnythingOfType("*echo.context"), accountTypeID, mock.AnythingOfType("models.AccountsReadAccountTypeParams")).Return(func(c echo.Context, accountTypeID string, params models.AccountsReadAccountTypeParams) error {
+		return c.JSON(200, &models.AccountsReadAccountTypes{
+			AccountTypes: []models.AccountType{
+				{
+					Id:       aws.String(accountTypeID),
+					Name:     "production",
+					Policies: policies,
+				},
+			},
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxAccountTypePolicyAttachmentConfig("production", accountTypeID, policyID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_account_type_policy_attachment.production", "id", accountTypeID),
+					resource.TestCheckResourceAttr("stax_account_type_policy_attachment.production", "policy_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStaxAccountTypePolicyAttachmentConfig(label, accountTypeID, policyID string) string {
+	configTemplate := `
+resource "stax_account_type_policy_attachment" "${label}" {
+	account_type_id = "${account_type_id}"
+	policy_ids = [
+		"${policy_id}"
+	]
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":           label,
+			"account_type_id": accountTypeID,
+			"policy_id":       policyID,
+		},
+	)
+}
+
+func TestAccountTypePolicyAttachmentResource_updateAccountTypePolicies(t *testing.T) {
+	accountTypeID := "3b7f3e2a-52a4-4b1b-9d0b-1c5f6ad3c0e2"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	policyData := func(t *testing.T, policyIDs ...string) *AccountTypePolicyAttachmentResourceModel {
+		policyIDsSet, diags := types.SetValueFrom(context.Background(), types.StringType, policyIDs)
+		require.False(t, diags.HasError())
+
+		return &AccountTypePolicyAttachmentResourceModel{
+			AccountTypeID: types.StringValue(accountTypeID),
+			PolicyIDs:     policyIDsSet,
+		}
+	}
+
+	t.Run("attaches added and detaches removed policies", func(t *testing.T) {
+		clientMock := newClientMock(t)
+
+		clientMock.onAccountTypeRead(models.AccountType{Id: aws.String(accountTypeID), Policies: []string{"policy-1", "policy-2"}})
+
+		updateEvent := &models.AccountsUpdateAccountTypePoliciesEvent{}
+		updateEvent.Detail.TaskId = aws.String(taskID)
+
+		clientMock.On("AccountTypeUpdatePolicies", mock.Anything, accountTypeID, []string{"policy-3"}, []string{"policy-1"}).Return(&client.AccountsUpdateAccountTypePoliciesResp{
+			JSON200: updateEvent,
+		}, nil).Once()
+
+		clientMock.onTaskSucceeded(taskID)
+
+		r := &AccountTypePolicyAttachmentResource{client: clientMock}
+
+		diags := r.updateAccountTypePolicies(context.Background(), policyData(t, "policy-2", "policy-3"), timeoutUpdate, 0)
+		require.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("does not update unchanged policies", func(t *testing.T) {
+		clientMock := newClientMock(t)
+
+		clientMock.onAccountTypeRead(models.AccountType{Id: aws.String(accountTypeID), Policies: []string{"policy-1"}})
+
+		r := &AccountTypePolicyAttachmentResource{client: clientMock}
+
+		diags := r.updateAccountTypePolicies(context.Background(), policyData(t, "policy-1"), timeoutUpdate, 0)
+		require.False(t, diags.HasError(), diags)
+	})
+}
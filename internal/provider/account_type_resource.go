@@ -119,6 +119,10 @@ func (r *AccountTypeResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	err := r.readAccountType(ctx, data.ID.ValueString(), data)
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account type, got error: %s", err))
 		return
@@ -153,7 +153,21 @@ func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	resp.Diagnostics.Append(r.apiTokenRead(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiTokenRead, err := r.client.APITokenReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read api token, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(apiTokenAPIToTFResource(ctx, apiTokenRead.JSON200, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -234,7 +248,13 @@ func (r *APITokenResource) apiTokenRead(ctx context.Context, userID string, data
 		return diags
 	}
 
-	for _, apiToken := range apiTokenRead.JSON200.ApiTokens {
+	return apiTokenAPIToTFResource(ctx, apiTokenRead.JSON200, data)
+}
+
+func apiTokenAPIToTFResource(ctx context.Context, apiTokens *models.TeamsReadApiTokens, data *APITokenResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, apiToken := range apiTokens.ApiTokens {
 
 		m := tokenTagsToMap(apiToken.Tags)
 
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+var _ datasource.DataSource = &CatalogueItemDataSource{}
+
+func NewCatalogueItemDataSource() datasource.DataSource {
+	return &CatalogueItemDataSource{}
+}
+
+// CatalogueItemDataSource defines the data source implementation.
+type CatalogueItemDataSource struct {
+	client staxsdk.ClientInterface
+}
+
+// CatalogueItemSelectedDataSourceModel describes the data source data model.
+type CatalogueItemSelectedDataSourceModel struct {
+	ID                  types.String                      `tfsdk:"id"`
+	Name                types.String                      `tfsdk:"name"`
+	VersionID           types.String                      `tfsdk:"version_id"`
+	TemplateName        types.String                      `tfsdk:"template_name"`
+	Description         types.String                      `tfsdk:"description"`
+	Status              types.String                      `tfsdk:"status"`
+	LatestVersionID     types.String                      `tfsdk:"latest_version_id"`
+	Versions            []CatalogueVersionDataSourceModel `tfsdk:"versions"`
+	Version             types.String                      `tfsdk:"version"`
+	Parameters          types.List                        `tfsdk:"parameters"`
+	Outputs             types.List                        `tfsdk:"outputs"`
+	ManifestDownloadURL types.String                      `tfsdk:"manifest_download_url"`
+	TemplateDownloadURL types.String                      `tfsdk:"template_download_url"`
+}
+
+func (d *CatalogueItemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalogue_item"
+}
+
+func (d *CatalogueItemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := catalogueItemDataSourceAttributes()
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The identifier of the workload catalogue item, either `id` or `name` must be set",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the workload catalogue item, either `id` or `name` must be set",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["version_id"] = schema.StringAttribute{
+		MarkdownDescription: "The identifier of the version to read, this defaults to the most recently created version with an `ACTIVE` status",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["template_name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of a template in the manifest of the version, when set `template_download_url` is populated",
+		Optional:            true,
+	}
+	attributes["version"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the selected version",
+		Computed:            true,
+	}
+	attributes["parameters"] = schema.ListAttribute{
+		MarkdownDescription: "The parameter schema of the manifest of the selected version, each parameter is a map of its properties, values which aren't strings are JSON encoded",
+		Computed:            true,
+		ElementType:         catalogueParameterType,
+	}
+	attributes["outputs"] = schema.ListAttribute{
+		MarkdownDescription: "The names of the outputs of the manifest of the selected version",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["manifest_download_url"] = schema.StringAttribute{
+		MarkdownDescription: "A temporary URL which can be used to download the manifest of the selected version",
+		Computed:            true,
+	}
+	attributes["template_download_url"] = schema.StringAttribute{
+		MarkdownDescription: "A temporary URL which can be used to download the rendered template named by `template_name`",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workload catalogue item datasource, this reads a single catalogue item by identifier or name along with a selected version, which defaults to the latest active version.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *CatalogueItemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogueItemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogueItemSelectedDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogueItem, err := d.readCatalogueItem(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue item, got error: %s", err))
+		return
+	}
+
+	catalogueItemModel, diags := catalogueItemAPIToTFDataSource(ctx, *catalogueItem)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = catalogueItemModel.ID
+	data.Name = catalogueItemModel.Name
+	data.Description = catalogueItemModel.Description
+	data.Status = catalogueItemModel.Status
+	data.LatestVersionID = catalogueItemModel.LatestVersionID
+	data.Versions = catalogueItemModel.Versions
+
+	versionID := data.VersionID.ValueString()
+	if versionID == "" {
+		versionID = data.LatestVersionID.ValueString()
+	}
+
+	if versionID == "" {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue item, catalogue item %s has no active versions", data.ID.ValueString()))
+		return
+	}
+
+	var selected *CatalogueVersionDataSourceModel
+
+	for i, version := range data.Versions {
+		if version.ID.ValueString() == versionID {
+			selected = &data.Versions[i]
+		}
+	}
+
+	if selected == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue item, version %s not found in catalogue item %s", versionID, data.ID.ValueString()))
+		return
+	}
+
+	tflog.Info(ctx, "reading catalogue item", map[string]interface{}{
+		"id":         data.ID.ValueString(),
+		"version_id": versionID,
+	})
+
+	data.VersionID = selected.ID
+	data.Version = selected.Version
+	data.Parameters = selected.Parameters
+	data.Outputs = selected.Outputs
+
+	manifestResp, err := d.client.CatalogueManifestRead(ctx, versionID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue manifest, got error: %s", err))
+		return
+	}
+
+	data.ManifestDownloadURL = types.StringNull()
+	if manifestResp.JSON200 != nil {
+		data.ManifestDownloadURL = types.StringValue(manifestResp.JSON200.Url)
+	}
+
+	data.TemplateDownloadURL = types.StringNull()
+
+	if templateName := data.TemplateName.ValueString(); templateName != "" {
+		templateResp, err := d.client.CatalogueTemplateRead(ctx, versionID, templateName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue template, got error: %s", err))
+			return
+		}
+
+		if templateResp.JSON200 != nil {
+			data.TemplateDownloadURL = types.StringValue(templateResp.JSON200.Url)
+		}
+	}
+
+	tflog.Trace(ctx, "read catalogue item from data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *CatalogueItemDataSource) readCatalogueItem(ctx context.Context, data CatalogueItemSelectedDataSourceModel) (*models.WorkloadCatalogue, error) {
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		return d.client.CatalogueItemReadByID(ctx, data.ID.ValueString())
+	}
+
+	name := data.Name.ValueString()
+
+	catalogueItems, err := d.client.CatalogueItemRead(ctx, &models.WorkloadsReadCatalogueItemsParams{
+		Name:              aws.String(name),
+		IncludeVersions:   aws.Bool(true),
+		IncludeParameters: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.WorkloadCatalogue
+
+	// the name filter may return partial matches, so only exact matches which haven't been deleted are kept
+	for _, catalogueItem := range catalogueItems {
+		if catalogueItem.Name != name {
+			continue
+		}
+
+		if catalogueItem.Status != nil && *catalogueItem.Status == models.CatalogueStatusDELETED {
+			continue
+		}
+
+		matches = append(matches, catalogueItem)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, &staxsdk.NotFoundError{Resource: "catalogue item", ID: name}
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, errors.New("more than one catalogue item named " + name + " exists, use id instead")
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCatalogueItemDataSource(t *testing.T) {
+
+	catalogueID := "8d2ed5d5-1b5b-4c47-a9e2-b6bc4bd0b08f"
+	oldVersionID := "0e0cbc70-3c0a-4a7b-8c56-6c0a9a2d8e61"
+	latestVersionID := "d6a4b7cf-9ff5-4d70-9a88-c6b25b6ac7a4"
+	createdTS := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	si := mocks.NewServerInterface(t)
+
+	readCatalogueItemsParams := models.WorkloadsReadCatalogueItemsParams{
+		Name:              aws.String("s3-bucket"),
+		Offset:            aws.Int(0),
+		Limit:             aws.Int(staxsdk.DefaultPageSize),
+		IncludeVersions:   aws.Bool(true),
+		IncludeParameters: aws.Bool(true),
+	}
+
+	si.On("WorkloadsReadCatalogueItems",
+		mock.AnythingOfType("*echo.context"),
+		readCatalogueItemsParams,
+	).Return(func(c echo.Context, params models.WorkloadsReadCatalogueItemsParams) error {
+		return c.JSON(200, &models.WorkloadsReadCatalogueItems{
+			WorkloadCatalogues: []models.WorkloadsCatalogue{
+				{
+					WorkloadCatalogueItems: []models.WorkloadCatalogue{
+						{
+							Id:     aws.String(catalogueID),
+							Name:   "s3-bucket",
+							Status: catalogueStatusPtr(models.CatalogueStatusACTIVE),
+							Versions: &[]models.WorkloadCatalogueVersion{
+								{
+									Id:              aws.String(oldVersionID),
+									WorkloadVersion: aws.String("1.0.0"),
+									Status:          catalogueVersionStatusPtr(models.WorkloadCatalogueVersionStatusACTIVE),
+									CreatedTS:       aws.Time(createdTS),
+								},
+								{
+									Id:              aws.String(latestVersionID),
+									WorkloadVersion: aws.String("1.1.0"),
+									Status:          catalogueVersionStatusPtr(models.WorkloadCatalogueVersionStatusACTIVE),
+									CreatedTS:       aws.Time(createdTS.Add(time.Hour)),
+									Outputs:         &[]string{"BucketName"},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	si.On("WorkloadsReadCatalogueManifest",
+		mock.AnythingOfType("*echo.context"),
+		latestVersionID,
+	).Return(func(c echo.Context, versionID string) error {
+		return c.JSON(200, &models.WorkloadsReadCatalogueManifest{
+			Url: "https://example.com/manifest.yml",
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories:  testAccProtoV6ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "stax_catalogue_item" "s3_bucket" {name = "s3-bucket"}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.stax_catalogue_item.s3_bucket", "id", catalogueID),
+					resource.TestCheckResourceAttr("data.stax_catalogue_item.s3_bucket", "version_id", latestVersionID),
+					resource.TestCheckResourceAttr("data.stax_catalogue_item.s3_bucket", "version", "1.1.0"),
+					resource.TestCheckResourceAttr("data.stax_catalogue_item.s3_bucket", "outputs.0", "BucketName"),
+					resource.TestCheckResourceAttr("data.stax_catalogue_item.s3_bucket", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.stax_catalogue_item.s3_bucket", "manifest_download_url", "https://example.com/manifest.yml"),
+				),
+			},
+		},
+	})
+}
+
+func TestLatestCatalogueVersion(t *testing.T) {
+	createdTS := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	catalogueItem := models.WorkloadCatalogue{
+		Versions: &[]models.WorkloadCatalogueVersion{
+			{
+				Id:        aws.String("old"),
+				Status:    catalogueVersionStatusPtr(models.WorkloadCatalogueVersionStatusACTIVE),
+				CreatedTS: aws.Time(createdTS),
+			},
+			{
+				Id:        aws.String("latest"),
+				Status:    catalogueVersionStatusPtr(models.WorkloadCatalogueVersionStatusACTIVE),
+				CreatedTS: aws.Time(createdTS.Add(time.Hour)),
+			},
+			{
+				Id:        aws.String("failed"),
+				Status:    catalogueVersionStatusPtr(models.WorkloadCatalogueVersionStatusFAILED),
+				CreatedTS: aws.Time(createdTS.Add(2 * time.Hour)),
+			},
+		},
+	}
+
+	latest := latestCatalogueVersion(catalogueItem)
+	assert.Equal(t, "latest", aws.ToString(latest.Id))
+
+	assert.Nil(t, latestCatalogueVersion(models.WorkloadCatalogue{}))
+}
+
+func catalogueStatusPtr(status models.CatalogueStatus) *models.CatalogueStatus {
+	return &status
+}
+
+func catalogueVersionStatusPtr(status models.WorkloadCatalogueVersionStatus) *models.WorkloadCatalogueVersionStatus {
+	return &status
+}
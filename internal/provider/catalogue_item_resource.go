@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogueItemResource{}
+var _ resource.ResourceWithConfigure = &CatalogueItemResource{}
+
+type CatalogueItemResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	Version            types.String   `tfsdk:"version"`
+	ManifestBody       types.String   `tfsdk:"manifest_body"`
+	ManifestURL        types.String   `tfsdk:"manifest_url"`
+	Parameters         types.Map      `tfsdk:"parameters"`
+	Tags               types.Map      `tfsdk:"tags"`
+	CatalogueVersionID types.String   `tfsdk:"catalogue_version_id"`
+	Status             types.String   `tfsdk:"status"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewCatalogueItemResource() resource.Resource {
+	return &CatalogueItemResource{}
+}
+
+// CatalogueItemResource defines the resource implementation.
+type CatalogueItemResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *CatalogueItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalogue_item"
+}
+
+func (r *CatalogueItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workload catalogue item resource. Catalogue items are published with a first version, use the `stax_catalogue_version` resource to publish later versions. Stax can't update catalogue items, so changing any argument replaces the catalogue item.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Workload catalogue item identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workload catalogue item",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the workload catalogue item",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The name of the first version of the workload catalogue item, for example `1.0.0`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_body": schema.StringAttribute{
+				MarkdownDescription: "The raw text of the workload manifest, either this or `manifest_url` must be provided",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("manifest_url")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_url": schema.StringAttribute{
+				MarkdownDescription: "The HTTPS or S3 URL of the workload manifest, either this or `manifest_body` must be provided",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "The default values of the parameters in the workload manifest",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the workload catalogue item",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalogue_version_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the first version of the workload catalogue item",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the workload catalogue item",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
+	}
+}
+
+func (r *CatalogueItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogueItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CatalogueItemResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := catalogueParametersTFToAPI(ctx, data.Parameters)
+	resp.Diagnostics.Append(diags...)
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CatalogueItemCreate(ctx, models.WorkloadsCreateCatalogueItem{
+		Name:         data.Name.ValueString(),
+		Description:  data.Description.ValueString(),
+		Version:      data.Version.ValueString(),
+		ManifestBody: data.ManifestBody.ValueStringPointer(),
+		ManifestURL:  data.ManifestURL.ValueStringPointer(),
+		Parameters:   parameters,
+		Tags:         (*models.Tags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalogue item, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "catalogue item create response", map[string]interface{}{
+		"body": string(created.Body),
+	})
+
+	catalogueID, err := staxsdk.EventCatalogueID(created.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue item identifier, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogueItem, err := r.client.CatalogueItemReadByID(ctx, catalogueID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue item, got error: %s", err))
+		return
+	}
+
+	catalogueItemAPIToTFResource(*catalogueItem, data)
+
+	version := findCatalogueVersion(catalogueItem, data.Version.ValueString())
+	if version == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find version %s of catalogue item %s", data.Version.ValueString(), catalogueID))
+		return
+	}
+
+	data.CatalogueVersionID = types.StringPointerValue(version.Id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogueItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CatalogueItemResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogueItem, err := r.client.CatalogueItemReadByID(ctx, data.ID.ValueString())
+	if err == nil && catalogueItem.Status != nil && *catalogueItem.Status == models.CatalogueStatusDELETED {
+		// deleted catalogue items are still returned by the API
+		err = &staxsdk.NotFoundError{Resource: "catalogue item", ID: data.ID.ValueString()}
+	}
+
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue item, got error: %s", err))
+		return
+	}
+
+	catalogueItemAPIToTFResource(*catalogueItem, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only saves changes to the timeouts block, as all other changes replace the catalogue item.
+func (r *CatalogueItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CatalogueItemResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogueItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CatalogueItemResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete catalogue item", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.CatalogueItemDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalogue item, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func catalogueItemAPIToTFResource(catalogueItem models.WorkloadCatalogue, data *CatalogueItemResourceModel) {
+	data.ID = types.StringPointerValue(catalogueItem.Id)
+	data.Name = types.StringValue(catalogueItem.Name)
+	data.Description = types.StringPointerValue(catalogueItem.Description)
+	data.Status = types.StringPointerValue((*string)(catalogueItem.Status))
+}
+
+// findCatalogueVersion returns the version of the catalogue item with the version name, or nil if there isn't one.
+func findCatalogueVersion(catalogueItem *models.WorkloadCatalogue, version string) *models.WorkloadCatalogueVersion {
+	if catalogueItem.Versions == nil {
+		return nil
+	}
+
+	for _, catalogueVersion := range *catalogueItem.Versions {
+		if aws.ToString(catalogueVersion.WorkloadVersion) == version {
+			return &catalogueVersion
+		}
+	}
+
+	return nil
+}
+
+func catalogueParametersTFToAPI(ctx context.Context, parameters types.Map) (*models.Parameter, diag.Diagnostics) {
+	values := make(map[string]string)
+
+	diags := parameters.ElementsAs(ctx, &values, false)
+	if diags.HasError() || len(values) == 0 {
+		return nil, diags
+	}
+
+	apiParameters := make(models.Parameter, len(values))
+	for key, value := range values {
+		apiParameters[key] = value
+	}
+
+	return &apiParameters, diags
+}
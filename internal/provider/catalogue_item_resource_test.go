@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestCatalogueItemResource(t *testing.T) {
+
+	catalogueID := "3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11"
+	catalogueVersionID := "5a0c9f6e-3bde-4d6b-8f2b-2a1c7f0b8e44"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	si.On("WorkloadsCreateCatalogueItem", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		cci := new(models.WorkloadsCreateCatalogueItem)
+		if err := c.Bind(cci); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if cci.ManifestBody == nil || cci.ManifestURL != nil {
+			t.Errorf("expected only a manifest body")
+		}
+
+		return c.JSON(200, map[string]interface{}{
+			"DetailType":  "stax.workload.catalogue.create",
+			"TaskId":      taskID,
+			"CatalogueId": catalogueID,
+		})
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("WorkloadsReadCatalogueItem", mock.AnythingOfType("*echo.context"), catalogueID, mock.AnythingOfType("models.WorkloadsReadCatalogueItemParams")).Return(func(c echo.Context, catalogueID string, params models.WorkloadsReadCatalogueItemParams) error {
+		status := models.CatalogueStatusACTIVE
+
+		return c.JSON(200, &models.WorkloadsReadCatalogueItems{
+			WorkloadCatalogues: []models.WorkloadsCatalogue{
+				{
+					WorkloadCatalogueItems: []models.WorkloadCatalogue{
+						{
+							Id:          aws.String(catalogueID),
+							Name:        "vpc",
+							Description: aws.String("a two tier vpc"),
+							Status:      &status,
+							Versions: &[]models.WorkloadCatalogueVersion{
+								{Id: aws.String(catalogueVersionID), WorkloadVersion: aws.String("1.0.0")},
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	si.On("WorkloadsDeleteCatalogueItem", mock.AnythingOfType("*echo.context"), catalogueID).Return(func(c echo.Context, catalogueID string) error {
+		return c.JSON(200, map[string]interface{}{
+			"DetailType": "stax.workload.catalogue.delete",
+			"TaskId":     taskID,
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxCatalogueItemConfig("vpc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_catalogue_item.vpc", "id", catalogueID),
+					resource.TestCheckResourceAttr("stax_catalogue_item.vpc", "catalogue_version_id", catalogueVersionID),
+					resource.TestCheckResourceAttr("stax_catalogue_item.vpc", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxCatalogueItemConfig(label string) string {
+	configTemplate := `
+resource "stax_catalogue_item" "${label}" {
+	name          = "vpc"
+	description   = "a two tier vpc"
+	version       = "1.0.0"
+	manifest_body = "Resources: []"
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label": label,
+		},
+	)
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"golang.org/x/exp/slices"
+)
+
+var _ datasource.DataSource = &CatalogueItemsDataSource{}
+
+func NewCatalogueItemsDataSource() datasource.DataSource {
+	return &CatalogueItemsDataSource{}
+}
+
+// CatalogueItemsDataSource defines the data source implementation.
+type CatalogueItemsDataSource struct {
+	client staxsdk.ClientInterface
+}
+
+type CatalogueItemDataSourceModel struct {
+	ID              types.String                      `tfsdk:"id"`
+	Name            types.String                      `tfsdk:"name"`
+	Description     types.String                      `tfsdk:"description"`
+	Status          types.String                      `tfsdk:"status"`
+	LatestVersionID types.String                      `tfsdk:"latest_version_id"`
+	Versions        []CatalogueVersionDataSourceModel `tfsdk:"versions"`
+}
+
+type CatalogueVersionDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Version     types.String `tfsdk:"version"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+	ManifestURL types.String `tfsdk:"manifest_url"`
+	Outputs     types.List   `tfsdk:"outputs"`
+	Parameters  types.List   `tfsdk:"parameters"`
+	CreatedTS   types.String `tfsdk:"created_ts"`
+}
+
+// CatalogueItemsDataSourceModel describes the data source data model.
+type CatalogueItemsDataSourceModel struct {
+	Filters        *CatalogueItemFiltersModel     `tfsdk:"filters"`
+	CatalogueItems []CatalogueItemDataSourceModel `tfsdk:"catalogue_items"`
+}
+
+type CatalogueItemFiltersModel struct {
+	IDs      types.List   `tfsdk:"ids"`
+	Name     types.String `tfsdk:"name"`
+	Statuses types.List   `tfsdk:"statuses"`
+}
+
+// catalogueParameterType is the type of each parameter in the parameter schema of a catalogue version.
+var catalogueParameterType = types.MapType{ElemType: types.StringType}
+
+func (d *CatalogueItemsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalogue_items"
+}
+
+func (d *CatalogueItemsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workload catalogue items datasource",
+
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.ListAttribute{
+						MarkdownDescription: "A list of identifiers used to filter catalogue items",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "A name used to filter catalogue items",
+						Optional:            true,
+					},
+					"statuses": schema.ListAttribute{
+						MarkdownDescription: "A list of statuses used to filter catalogue items, for example `ACTIVE`",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"catalogue_items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: catalogueItemDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *CatalogueItemsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogueItemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogueItemsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &models.WorkloadsReadCatalogueItemsParams{
+		IncludeVersions:   aws.Bool(true),
+		IncludeParameters: aws.Bool(true),
+	}
+
+	var statuses []string
+
+	if data.Filters != nil {
+		ids := new([]string)
+		resp.Diagnostics.Append(data.Filters.IDs.ElementsAs(ctx, ids, false)...)
+		resp.Diagnostics.Append(data.Filters.Statuses.ElementsAs(ctx, &statuses, false)...)
+
+		params.IdFilter = helpers.CommaDelimitedOptionalValue(*ids)
+		params.Name = data.Filters.Name.ValueStringPointer()
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogueItems, err := d.client.CatalogueItemRead(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue items, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "reading catalogue items", map[string]interface{}{
+		"count": len(catalogueItems),
+	})
+
+	for _, catalogueItem := range catalogueItems {
+		// the API filters on task statuses, so the catalogue item statuses are filtered here
+		if len(statuses) > 0 && !slices.Contains(statuses, aws.ToString((*string)(catalogueItem.Status))) {
+			continue
+		}
+
+		catalogueItemModel, diags := catalogueItemAPIToTFDataSource(ctx, catalogueItem)
+		resp.Diagnostics.Append(diags...)
+
+		data.CatalogueItems = append(data.CatalogueItems, catalogueItemModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read catalogue items from data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// catalogueItemDataSourceAttributes returns the computed attributes of a catalogue item, these are shared by the
+// stax_catalogue_items and stax_catalogue_item data sources.
+func catalogueItemDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the workload catalogue item",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the workload catalogue item",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the workload catalogue item",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the workload catalogue item",
+			Computed:            true,
+		},
+		"latest_version_id": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the most recently created version with an `ACTIVE` status",
+			Computed:            true,
+		},
+		"versions": schema.ListNestedAttribute{
+			MarkdownDescription: "The versions of the workload catalogue item",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The identifier of the version",
+						Computed:            true,
+					},
+					"version": schema.StringAttribute{
+						MarkdownDescription: "The name of the version",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the version",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "The status of the version",
+						Computed:            true,
+					},
+					"manifest_url": schema.StringAttribute{
+						MarkdownDescription: "The HTTPS or S3 URL of the manifest of the version",
+						Computed:            true,
+					},
+					"outputs": schema.ListAttribute{
+						MarkdownDescription: "The names of the outputs of the manifest",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"parameters": schema.ListAttribute{
+						MarkdownDescription: "The parameter schema of the manifest, each parameter is a map of its properties, values which aren't strings are JSON encoded",
+						Computed:            true,
+						ElementType:         catalogueParameterType,
+					},
+					"created_ts": schema.StringAttribute{
+						MarkdownDescription: "The time the version was created",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func catalogueItemAPIToTFDataSource(ctx context.Context, catalogueItem models.WorkloadCatalogue) (CatalogueItemDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	catalogueItemModel := CatalogueItemDataSourceModel{
+		ID:              types.StringPointerValue(catalogueItem.Id),
+		Name:            types.StringValue(catalogueItem.Name),
+		Description:     types.StringPointerValue(catalogueItem.Description),
+		Status:          types.StringPointerValue((*string)(catalogueItem.Status)),
+		LatestVersionID: types.StringNull(),
+		Versions:        []CatalogueVersionDataSourceModel{},
+	}
+
+	if latest := latestCatalogueVersion(catalogueItem); latest != nil {
+		catalogueItemModel.LatestVersionID = types.StringPointerValue(latest.Id)
+	}
+
+	if catalogueItem.Versions == nil {
+		return catalogueItemModel, diags
+	}
+
+	for _, version := range *catalogueItem.Versions {
+		versionModel, d := catalogueVersionAPIToTFDataSource(ctx, version)
+		diags.Append(d...)
+
+		catalogueItemModel.Versions = append(catalogueItemModel.Versions, versionModel)
+	}
+
+	return catalogueItemModel, diags
+}
+
+func catalogueVersionAPIToTFDataSource(ctx context.Context, version models.WorkloadCatalogueVersion) (CatalogueVersionDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	versionModel := CatalogueVersionDataSourceModel{
+		ID:          types.StringPointerValue(version.Id),
+		Version:     types.StringPointerValue(version.WorkloadVersion),
+		Description: types.StringPointerValue(version.Description),
+		Status:      types.StringPointerValue((*string)(version.Status)),
+		ManifestURL: types.StringValue(version.ManifestURL),
+		CreatedTS:   types.StringPointerValue(timeToStringPtr(version.CreatedTS)),
+	}
+
+	outputs := []string{}
+	if version.Outputs != nil {
+		outputs = *version.Outputs
+	}
+
+	var d diag.Diagnostics
+	versionModel.Outputs, d = types.ListValueFrom(ctx, types.StringType, outputs)
+	diags.Append(d...)
+
+	parameters := []attr.Value{}
+
+	if version.Parameters != nil {
+		for _, parameter := range *version.Parameters {
+			parameter := parameter
+
+			values, err := workloadParametersAPIToTF(&parameter)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read catalogue version parameters, got error: %s", err))
+				return versionModel, diags
+			}
+
+			parameterValue, d := types.MapValue(types.StringType, values)
+			diags.Append(d...)
+
+			parameters = append(parameters, parameterValue)
+		}
+	}
+
+	versionModel.Parameters, d = types.ListValue(catalogueParameterType, parameters)
+	diags.Append(d...)
+
+	return versionModel, diags
+}
+
+// latestCatalogueVersion returns the most recently created version of the catalogue item with an ACTIVE status, or
+// nil if there isn't one.
+func latestCatalogueVersion(catalogueItem models.WorkloadCatalogue) *models.WorkloadCatalogueVersion {
+	if catalogueItem.Versions == nil {
+		return nil
+	}
+
+	var latest *models.WorkloadCatalogueVersion
+
+	for i, version := range *catalogueItem.Versions {
+		if version.Status == nil || *version.Status != models.WorkloadCatalogueVersionStatusACTIVE {
+			continue
+		}
+
+		if latest == nil || (version.CreatedTS != nil && (latest.CreatedTS == nil || version.CreatedTS.After(*latest.CreatedTS))) {
+			latest = &(*catalogueItem.Versions)[i]
+		}
+	}
+
+	return latest
+}
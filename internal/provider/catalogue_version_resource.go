@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogueVersionResource{}
+var _ resource.ResourceWithConfigure = &CatalogueVersionResource{}
+
+type CatalogueVersionResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	CatalogueID  types.String   `tfsdk:"catalogue_id"`
+	Version      types.String   `tfsdk:"version"`
+	Description  types.String   `tfsdk:"description"`
+	ManifestBody types.String   `tfsdk:"manifest_body"`
+	ManifestURL  types.String   `tfsdk:"manifest_url"`
+	Parameters   types.Map      `tfsdk:"parameters"`
+	Tags         types.Map      `tfsdk:"tags"`
+	Outputs      types.List     `tfsdk:"outputs"`
+	Status       types.String   `tfsdk:"status"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewCatalogueVersionResource() resource.Resource {
+	return &CatalogueVersionResource{}
+}
+
+// CatalogueVersionResource defines the resource implementation.
+type CatalogueVersionResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *CatalogueVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalogue_version"
+}
+
+func (r *CatalogueVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workload catalogue version resource. Publishes a new version of a workload catalogue item, Stax can't update catalogue versions, so changing any argument replaces the version.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Workload catalogue version identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalogue_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the workload catalogue item to publish the version to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The name of the version, for example `1.1.0`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the version",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_body": schema.StringAttribute{
+				MarkdownDescription: "The raw text of the workload manifest, either this or `manifest_url` must be provided",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("manifest_url")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_url": schema.StringAttribute{
+				MarkdownDescription: "The HTTPS or S3 URL of the workload manifest, either this or `manifest_body` must be provided",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "The default values of the parameters in the workload manifest",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the version",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"outputs": schema.ListAttribute{
+				MarkdownDescription: "The names of the outputs of the workload manifest",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the version",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
+	}
+}
+
+func (r *CatalogueVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogueVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CatalogueVersionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := catalogueParametersTFToAPI(ctx, data.Parameters)
+	resp.Diagnostics.Append(diags...)
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CatalogueVersionCreate(ctx, data.CatalogueID.ValueString(), models.WorkloadsCreateCatalogueVersion{
+		Description:  data.Description.ValueString(),
+		Version:      data.Version.ValueString(),
+		ManifestBody: data.ManifestBody.ValueStringPointer(),
+		ManifestURL:  data.ManifestURL.ValueStringPointer(),
+		Parameters:   parameters,
+		Tags:         (*models.Tags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalogue version, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "catalogue version create response", map[string]interface{}{
+		"body": string(created.Body),
+	})
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the create event doesn't include the version identifier, so look it up by name
+	catalogueItem, err := r.client.CatalogueItemReadByID(ctx, data.CatalogueID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue item, got error: %s", err))
+		return
+	}
+
+	version := findCatalogueVersion(catalogueItem, data.Version.ValueString())
+	if version == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find version %s of catalogue item %s", data.Version.ValueString(), data.CatalogueID.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(catalogueVersionAPIToTFResource(ctx, *version, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogueVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CatalogueVersionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionResp, err := r.client.CatalogueVersionReadByID(ctx, data.CatalogueID.ValueString(), data.ID.ValueString())
+	if err == nil {
+		status := versionResp.JSON200.Versions[0].Status
+		if status != nil && *status == models.WorkloadCatalogueVersionStatusDELETED {
+			// deleted catalogue versions are still returned by the API
+			err = &staxsdk.NotFoundError{Resource: "catalogue version", ID: data.ID.ValueString()}
+		}
+	}
+
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalogue version, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(catalogueVersionAPIToTFResource(ctx, versionResp.JSON200.Versions[0], data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only saves changes to the timeouts block, as all other changes replace the catalogue version.
+func (r *CatalogueVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CatalogueVersionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogueVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CatalogueVersionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete catalogue version", map[string]interface{}{
+		"catalogueID": data.CatalogueID.ValueString(),
+		"id":          data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.CatalogueVersionDelete(ctx, data.CatalogueID.ValueString(), data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalogue version, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func catalogueVersionAPIToTFResource(ctx context.Context, version models.WorkloadCatalogueVersion, data *CatalogueVersionResourceModel) diag.Diagnostics {
+	data.ID = types.StringPointerValue(version.Id)
+	data.Version = types.StringPointerValue(version.WorkloadVersion)
+	data.Description = types.StringPointerValue(version.Description)
+	data.Status = types.StringPointerValue((*string)(version.Status))
+
+	if version.CatalogueId != nil {
+		data.CatalogueID = types.StringValue(*version.CatalogueId)
+	}
+
+	outputs := []string{}
+	if version.Outputs != nil {
+		outputs = *version.Outputs
+	}
+
+	var diags diag.Diagnostics
+	data.Outputs, diags = types.ListValueFrom(ctx, types.StringType, outputs)
+
+	return diags
+}
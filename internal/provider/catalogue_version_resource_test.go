@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestCatalogueVersionResource(t *testing.T) {
+
+	catalogueID := "3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11"
+	catalogueVersionID := "9e4b2d7c-6f1a-4c3e-8b5d-0a2f4c6e8b13"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	version := models.WorkloadCatalogueVersion{
+		Id:              aws.String(catalogueVersionID),
+		CatalogueId:     aws.String(catalogueID),
+		WorkloadVersion: aws.String("1.1.0"),
+		Description:     aws.String("adds flow logs"),
+		Outputs:         &[]string{"VpcId"},
+	}
+
+	si.On("WorkloadsCreateCatalogueVersion", mock.AnythingOfType("*echo.context"), catalogueID).Return(func(c echo.Context, catalogueID string) error {
+		ccv := new(models.WorkloadsCreateCatalogueVersion)
+		if err := c.Bind(ccv); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if ccv.Version != "1.1.0" {
+			t.Errorf("unexpected version: %s", ccv.Version)
+		}
+
+		return c.JSON(200, map[string]interface{}{
+			"DetailType":  "stax.workload.catalogue.version.create",
+			"TaskId":      taskID,
+			"CatalogueId": catalogueID,
+		})
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("WorkloadsReadCatalogueItem", mock.AnythingOfType("*echo.context"), catalogueID, mock.AnythingOfType("models.WorkloadsReadCatalogueItemParams")).Return(func(c echo.Context, catalogueID string, params models.WorkloadsReadCatalogueItemParams) error {
+		return c.JSON(200, &models.WorkloadsReadCatalogueItems{
+			WorkloadCatalogues: []models.WorkloadsCatalogue{
+				{
+					WorkloadCatalogueItems: []models.WorkloadCatalogue{
+						{
+							Id:       aws.String(catalogueID),
+							Name:     "vpc",
+							Versions: &[]models.WorkloadCatalogueVersion{version},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	si.On("WorkloadsReadCatalogueVersion", mock.AnythingOfType("*echo.context"), catalogueID, catalogueVersionID, mock.AnythingOfType("models.WorkloadsReadCatalogueVersionParams")).Return(func(c echo.Context, catalogueID, versionID string, params models.WorkloadsReadCatalogueVersionParams) error {
+		return c.JSON(200, &models.WorkloadsReadCatalogueVersion{
+			Versions: []models.WorkloadCatalogueVersion{version},
+		})
+	})
+
+	si.On("WorkloadsDeleteCatalogueVersion", mock.AnythingOfType("*echo.context"), catalogueID, catalogueVersionID).Return(func(c echo.Context, catalogueID, versionID string) error {
+		return c.JSON(200, map[string]interface{}{
+			"DetailType": "stax.workload.catalogue.version.delete",
+			"TaskId":     taskID,
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxCatalogueVersionConfig("vpc_1_1", catalogueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_catalogue_version.vpc_1_1", "id", catalogueVersionID),
+					resource.TestCheckResourceAttr("stax_catalogue_version.vpc_1_1", "outputs.0", "VpcId"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxCatalogueVersionConfig(label, catalogueID string) string {
+	configTemplate := `
+resource "stax_catalogue_version" "${label}" {
+	catalogue_id = "${catalogue_id}"
+	version      = "1.1.0"
+	description  = "adds flow logs"
+	manifest_url = "s3://stax-workloads/vpc/1.1.0/manifest.yml"
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":        label,
+			"catalogue_id": catalogueID,
+		},
+	)
+}
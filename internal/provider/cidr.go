@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cidrValidator{}
+
+// cidrValidator validates that a string is an IPv4 CIDR in quad dot notation, such as "10.0.0.0/16", with a prefix
+// length between minPrefix and maxPrefix.
+type cidrValidator struct {
+	minPrefix int
+	maxPrefix int
+}
+
+// cidrPrefixBetween returns a validator which ensures the string is a well formed IPv4 CIDR with a prefix length
+// between minPrefix and maxPrefix.
+func cidrPrefixBetween(minPrefix, maxPrefix int) validator.String {
+	return cidrValidator{minPrefix: minPrefix, maxPrefix: maxPrefix}
+}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be an IPv4 CIDR, such as \"10.0.0.0/16\", with a prefix length between /%d and /%d", v.minPrefix, v.maxPrefix)
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	err := validateCidr(req.ConfigValue.ValueString(), v.minPrefix, v.maxPrefix)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("Attribute %s %s, got error: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+var _ validator.String = ipv4AddressValidator{}
+
+// ipv4AddressValidator validates that a string is an IPv4 address in quad dot notation, such as "10.0.0.2".
+type ipv4AddressValidator struct{}
+
+// ipv4Address returns a validator which ensures the string is a well formed IPv4 address.
+func ipv4Address() validator.String {
+	return ipv4AddressValidator{}
+}
+
+func (v ipv4AddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 address, such as \"10.0.0.2\""
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	ip := net.ParseIP(req.ConfigValue.ValueString())
+	if ip == nil || ip.To4() == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// validateCidr checks the CIDR is IPv4, has a prefix length between minPrefix and maxPrefix, and that the address is
+// the first address of the network, so "10.0.0.1/16" is rejected in favour of "10.0.0.0/16".
+func validateCidr(cidr string, minPrefix, maxPrefix int) error {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+
+	if ip.To4() == nil {
+		return fmt.Errorf("%s is not an IPv4 CIDR", cidr)
+	}
+
+	if !ip.Equal(network.IP) {
+		return fmt.Errorf("%s has host bits set, the network address is %s", cidr, network)
+	}
+
+	prefix, _ := network.Mask.Size()
+	if prefix < minPrefix || prefix > maxPrefix {
+		return fmt.Errorf("%s has a prefix length of /%d", cidr, prefix)
+	}
+
+	return nil
+}
+
+// findOverlappingCidr returns the identifier of the first CIDR in existing, keyed by identifier, which overlaps cidr.
+// Existing values which aren't valid CIDRs are ignored.
+func findOverlappingCidr(cidr string, existing map[string]string) (string, bool, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", false, err
+	}
+
+	for id, existingCidr := range existing {
+		_, existingNetwork, err := net.ParseCIDR(existingCidr)
+		if err != nil {
+			continue
+		}
+
+		// two CIDR blocks overlap when either contains the network address of the other
+		if network.Contains(existingNetwork.IP) || existingNetwork.Contains(network.IP) {
+			return id, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// checkCidrOverlap adds an attribute error for the cidr attribute when cidr overlaps one of the existing CIDRs of the
+// networking hub, so the overlap is reported before Stax starts creating the resource.
+func checkCidrOverlap(cidr string, existing map[string]string, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, ok, err := findOverlappingCidr(cidr, existing)
+	if err != nil {
+		diags.AddAttributeError(path.Root("cidr"), "Invalid CIDR", fmt.Sprintf("Unable to parse the CIDR %s, got error: %s", cidr, err))
+		return diags
+	}
+
+	if ok {
+		diags.AddAttributeError(
+			path.Root("cidr"),
+			"Overlapping CIDR",
+			fmt.Sprintf("The CIDR %s overlaps the %s %s (%s) of the networking hub, the %ss of a networking hub must not overlap.", cidr, kind, id, existing[id], kind),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCidr(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidr    string
+		wantErr bool
+	}{
+		{name: "accepts a network address", cidr: "10.0.0.0/16"},
+		{name: "accepts the smallest prefix", cidr: "10.0.0.0/8"},
+		{name: "rejects host bits", cidr: "10.0.0.1/16", wantErr: true},
+		{name: "rejects a missing prefix", cidr: "10.0.0.0", wantErr: true},
+		{name: "rejects IPv6", cidr: "fd00::/48", wantErr: true},
+		{name: "rejects a prefix outside the bounds", cidr: "10.0.0.0/24", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCidr(tt.cidr, 8, 23)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFindOverlappingCidr(t *testing.T) {
+	existing := map[string]string{
+		"range-1": "10.0.0.0/16",
+		"range-2": "172.16.0.0/12",
+	}
+
+	t.Run("finds a range containing the cidr", func(t *testing.T) {
+		id, ok, err := findOverlappingCidr("10.0.128.0/17", existing)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "range-1", id)
+	})
+
+	t.Run("finds a range contained by the cidr", func(t *testing.T) {
+		id, ok, err := findOverlappingCidr("172.0.0.0/8", existing)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "range-2", id)
+	})
+
+	t.Run("ignores adjacent ranges", func(t *testing.T) {
+		_, ok, err := findOverlappingCidr("10.1.0.0/16", existing)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+}
+
+func TestIPv4AddressValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "accepts an IPv4 address", value: "192.168.0.2"},
+		{name: "rejects a CIDR", value: "192.168.0.0/24", wantErr: true},
+		{name: "rejects IPv6", value: "fd00::2", wantErr: true},
+		{name: "rejects a host name", value: "dns.corp.example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("address"), ConfigValue: types.StringValue(tt.value)}
+			resp := &validator.StringResponse{}
+
+			ipv4Address().ValidateString(context.Background(), req, resp)
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+)
+
+// clientMock mocks the staxsdk.ClientInterface methods used by the resource unit tests, the embedded interface is nil
+// so calling any other method panics.
+type clientMock struct {
+	staxsdk.ClientInterface
+	mock.Mock
+}
+
+func newClientMock(t *testing.T) *clientMock {
+	m := &clientMock{}
+	m.Mock.Test(t)
+
+	t.Cleanup(func() { m.AssertExpectations(t) })
+
+	return m
+}
+
+func (m *clientMock) AccountTypeReadById(ctx context.Context, accountTypeID string) (*client.AccountsReadAccountTypeResp, error) {
+	args := m.Called(ctx, accountTypeID)
+
+	resp, _ := args.Get(0).(*client.AccountsReadAccountTypeResp)
+
+	return resp, args.Error(1)
+}
+
+func (m *clientMock) AccountTypeUpdatePolicies(ctx context.Context, accountTypeID string, addPolicyIDs []string, removePolicyIDs []string) (*client.AccountsUpdateAccountTypePoliciesResp, error) {
+	args := m.Called(ctx, accountTypeID, addPolicyIDs, removePolicyIDs)
+
+	resp, _ := args.Get(0).(*client.AccountsUpdateAccountTypePoliciesResp)
+
+	return resp, args.Error(1)
+}
+
+func (m *clientMock) AccountTypeUpdateAccess(ctx context.Context, updateAccess models.AccountsUpdateAccountTypeAccess) (*client.AccountsUpdateAccountTypeAccessResp, error) {
+	args := m.Called(ctx, updateAccess)
+
+	resp, _ := args.Get(0).(*client.AccountsUpdateAccountTypeAccessResp)
+
+	return resp, args.Error(1)
+}
+
+func (m *clientMock) AccountTypeUpdateMembers(ctx context.Context, updateMembers models.AccountsUpdateAccountTypeMembers) (*client.AccountsUpdateAccountTypeMembersResp, error) {
+	args := m.Called(ctx, updateMembers)
+
+	resp, _ := args.Get(0).(*client.AccountsUpdateAccountTypeMembersResp)
+
+	return resp, args.Error(1)
+}
+
+func (m *clientMock) GroupReadByID(ctx context.Context, groupID string) (*client.TeamsReadGroupResp, error) {
+	args := m.Called(ctx, groupID)
+
+	resp, _ := args.Get(0).(*client.TeamsReadGroupResp)
+
+	return resp, args.Error(1)
+}
+
+func (m *clientMock) MonitorTask(ctx context.Context, taskID string, callbackFunc func(context.Context, *client.TasksReadTaskResp) bool) (*client.TasksReadTaskResp, error) {
+	args := m.Called(ctx, taskID)
+
+	resp, _ := args.Get(0).(*client.TasksReadTaskResp)
+
+	return resp, args.Error(1)
+}
+
+// onTaskSucceeded expects the task to be monitored, and reports that it succeeded.
+func (m *clientMock) onTaskSucceeded(taskID string) {
+	m.On("MonitorTask", mock.Anything, taskID).Return(&client.TasksReadTaskResp{
+		JSON200:      &models.TasksReadTask{Status: staxsdk.TaskSucceeded},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil).Once()
+}
+
+// onAccountTypeRead expects the account type to be read, and returns it.
+func (m *clientMock) onAccountTypeRead(accountType models.AccountType) {
+	m.On("AccountTypeReadById", mock.Anything, *accountType.Id).Return(&client.AccountsReadAccountTypeResp{
+		JSON200:      &models.AccountsReadAccountTypes{AccountTypes: []models.AccountType{accountType}},
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+	}, nil)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a duration, such as "30s" or "5m", of at least min.
+type durationValidator struct {
+	min time.Duration
+}
+
+// durationAtLeast returns a validator which ensures the string is a duration of at least min.
+func durationAtLeast(min time.Duration) validator.String {
+	return durationValidator{min: min}
+}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a duration, such as \"30s\" or \"5m\", of at least %s", v.min)
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// parseDuration parses the duration value, adding an attribute error to diags if it is invalid.
+func parseDuration(value types.String, attributePath path.Path, diags *diag.Diagnostics) time.Duration {
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Duration",
+			fmt.Sprintf("The value must be a duration, such as \"30s\" or \"5m\", got error: %s", err),
+		)
+	}
+
+	return d
+}
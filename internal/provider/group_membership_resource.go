@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,8 +26,9 @@ var _ resource.ResourceWithConfigure = &GroupMembershipResource{}
 var _ resource.ResourceWithImportState = &GroupMembershipResource{}
 
 type GroupMembershipResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	UsersIDs types.Set    `tfsdk:"user_ids"`
+	ID       types.String   `tfsdk:"id"`
+	UsersIDs types.Set      `tfsdk:"user_ids"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewGroupMembershipResource() resource.Resource {
@@ -60,6 +62,9 @@ func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -92,6 +97,9 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
 	var addMemberUserIDs []string
 	resp.Diagnostics.Append(data.UsersIDs.ElementsAs(ctx, &addMemberUserIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -115,7 +123,7 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 
 	taskResp, err := waitForTask(ctx, *assignResp.JSON200.Detail.TaskId, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete assign users task, got error: %s", err))
+		addTaskError(&resp.Diagnostics, "Unable to complete assign users task", timeoutCreate, timeout, err)
 		return
 	}
 
@@ -139,12 +147,33 @@ func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	err := r.readGroup(ctx, data.ID.ValueString(), data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupsResp, err := r.client.GroupReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
 		return
 	}
 
+	// the membership is removed along with the group, deleted groups are still returned by the API
+	for _, group := range groupsResp.JSON200.Groups {
+		if group.Status == models.GroupStatusDELETED {
+			removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "group", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(groupMembershipAPIToTFResource(ctx, groupsResp.JSON200.Groups, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -159,6 +188,13 @@ func (r *GroupMembershipResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel, timeout := withTaskTimeout(ctx, planData.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, "update group membership", map[string]interface{}{
 		"id": planData.ID.ValueString(),
 	})
@@ -175,7 +211,7 @@ func (r *GroupMembershipResource) Update(ctx context.Context, req resource.Updat
 
 	taskResp, err := waitForTask(ctx, *assignResp.JSON200.Detail.TaskId, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete assign users task, got error: %s", err))
+		addTaskError(&resp.Diagnostics, "Unable to complete assign users task", timeoutUpdate, timeout, err)
 		return
 	}
 
@@ -198,6 +234,9 @@ func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.Delet
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
 	var deleteMemberUserIDs []string
 	resp.Diagnostics.Append(data.UsersIDs.ElementsAs(ctx, &deleteMemberUserIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -221,7 +260,7 @@ func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.Delet
 
 	taskResp, err := waitForTask(ctx, *assignResp.JSON200.Detail.TaskId, r.client)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete remove users task, got error: %s", err))
+		addTaskError(&resp.Diagnostics, "Unable to complete remove users task", timeoutDelete, timeout, err)
 		return
 	}
 
@@ -244,11 +283,17 @@ func (r *GroupMembershipResource) readGroup(ctx context.Context, groupID string,
 		return diags
 	}
 
+	return groupMembershipAPIToTFResource(ctx, groupsResp.JSON200.Groups, data)
+}
+
+func groupMembershipAPIToTFResource(ctx context.Context, groups []models.Group, data *GroupMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	tflog.Info(ctx, "reading groups", map[string]interface{}{
-		"count": len(groupsResp.JSON200.Groups),
+		"count": len(groups),
 	})
 
-	for _, group := range groupsResp.JSON200.Groups {
+	for _, group := range groups {
 
 		if group.Users != nil {
 			slices.Sort(*group.Users)
@@ -279,7 +324,7 @@ func (r *GroupMembershipResource) updateGroupMembership(ctx context.Context, gro
 	userIDs := make([]string, 0)
 	diags.Append(data.UsersIDs.ElementsAs(ctx, &userIDs, false)...)
 
-	planGroupUsers := userIDMap(userIDs)
+	planGroupUsers := helpers.ToSet(userIDs)
 
 	addedUserIDs := helpers.Subtract(planGroupUsers, currentGroupUsers)
 	removedUserIDs := helpers.Subtract(currentGroupUsers, planGroupUsers)
@@ -310,13 +355,3 @@ func groupsToUserIDMap(groups []models.Group) map[string]bool {
 
 	return m
 }
-
-func userIDMap(userIDs []string) map[string]bool {
-	m := make(map[string]bool)
-
-	for _, user := range userIDs {
-		m[user] = true
-	}
-
-	return m
-}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
 )
 
@@ -129,7 +130,15 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.readGroup(ctx, data.ID.ValueString(), data)
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
 		return
@@ -241,6 +250,11 @@ func (r *GroupResource) readGroup(ctx context.Context, groupID string, data *Gro
 	})
 
 	for _, group := range groupsResp.JSON200.Groups {
+		// deleted groups are still returned by the API
+		if group.Status == models.GroupStatusDELETED {
+			return &staxsdk.NotFoundError{Resource: "group", ID: groupID}
+		}
+
 		data.ID = types.StringValue(*group.Id)
 		data.Name = types.StringValue(group.Name)
 		data.Type = types.StringValue(string(group.GroupType))
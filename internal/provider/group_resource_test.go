@@ -1,17 +1,21 @@
 package provider
 
 import (
+	"context"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/valyala/fasttemplate"
 )
 
@@ -96,3 +100,37 @@ resource "stax_group" "${label}" {
 		},
 	)
 }
+
+func TestGroupResource_readGroup(t *testing.T) {
+	groupID := "87c570e2-c795-44b0-aefa-ebdcffd4d048"
+
+	readGroupResp := func(status models.GroupStatus) *client.TeamsReadGroupResp {
+		return &client.TeamsReadGroupResp{
+			JSON200: &models.TeamsReadGroupsResponse{
+				Groups: []models.Group{{Id: aws.String(groupID), Name: "cost-data-scientist", GroupType: models.LOCAL, Status: status}},
+			},
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		}
+	}
+
+	t.Run("reads the group into the model", func(t *testing.T) {
+		clientMock := newClientMock(t)
+		clientMock.On("GroupReadByID", mock.Anything, groupID).Return(readGroupResp(models.GroupStatusACTIVE), nil).Once()
+
+		r := &GroupResource{client: clientMock}
+
+		data := &GroupResourceModel{}
+		require.NoError(t, r.readGroup(context.Background(), groupID, data))
+		require.Equal(t, "cost-data-scientist", data.Name.ValueString())
+	})
+
+	t.Run("reports deleted groups as not found", func(t *testing.T) {
+		clientMock := newClientMock(t)
+		clientMock.On("GroupReadByID", mock.Anything, groupID).Return(readGroupResp(models.GroupStatusDELETED), nil).Once()
+
+		r := &GroupResource{client: clientMock}
+
+		err := r.readGroup(context.Background(), groupID, &GroupResourceModel{})
+		require.True(t, staxsdk.IsNotFound(err), err)
+	})
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingCidrExclusionResource{}
+var _ resource.ResourceWithConfigure = &NetworkingCidrExclusionResource{}
+var _ resource.ResourceWithImportState = &NetworkingCidrExclusionResource{}
+
+type NetworkingCidrExclusionResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	NetworkingHubID types.String `tfsdk:"networking_hub_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Cidr            types.String `tfsdk:"cidr"`
+	Tags            types.Map    `tfsdk:"tags"`
+	Status          types.String `tfsdk:"status"`
+}
+
+func NewNetworkingCidrExclusionResource() resource.Resource {
+	return &NetworkingCidrExclusionResource{}
+}
+
+// NetworkingCidrExclusionResource defines the resource implementation.
+type NetworkingCidrExclusionResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingCidrExclusionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_cidr_exclusion"
+}
+
+func (r *NetworkingCidrExclusionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking CIDR exclusion resource. A CIDR exclusion reserves a CIDR within the CIDR ranges of a networking hub so it is never allocated to a VPC, for example a range already used by an on premise network. The CIDR is checked against the existing CIDR exclusions of the hub before it is created, as the CIDR exclusions of a hub must not overlap.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "CIDR exclusion identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"networking_hub_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the networking hub the CIDR exclusion belongs to, changing this replaces the CIDR exclusion",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the CIDR exclusion",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the CIDR exclusion",
+				Optional:            true,
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "The CIDR to exclude in quad dot notation, with a prefix length between /8 and /32, changing this replaces the CIDR exclusion",
+				Required:            true,
+				Validators: []validator.String{
+					cidrPrefixBetween(8, 32),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the CIDR exclusion",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the CIDR exclusion",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NetworkingCidrExclusionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingCidrExclusionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingCidrExclusionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exclusionsResp, err := r.client.NetworkingCidrExclusionReadByHub(ctx, data.NetworkingHubID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking hub cidr exclusions, got error: %s", err))
+		return
+	}
+
+	var existing map[string]string
+	if exclusionsResp.JSON200 != nil {
+		existing = existingCidrExclusions(exclusionsResp.JSON200.Exclusions)
+	}
+
+	resp.Diagnostics.Append(checkCidrOverlap(data.Cidr.ValueString(), existing, "CIDR exclusion")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.NetworkingCidrExclusionCreate(ctx, data.NetworkingHubID.ValueString(), models.NetworkingCreateCidrExclusion{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Cidr:        data.Cidr.ValueString(),
+		Tags:        (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking cidr exclusion, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking cidr exclusion create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	var exclusionID string
+
+	if created.JSON200 != nil {
+		for _, cidrExclusion := range created.JSON200.Exclusions {
+			if cidrExclusion.Cidr == data.Cidr.ValueString() && cidrExclusion.Id != nil {
+				exclusionID = *cidrExclusion.Id
+				break
+			}
+		}
+	}
+
+	if exclusionID == "" {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking cidr exclusion, nil cidr exclusion id in response")
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingCidrExclusion(ctx, exclusionID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingCidrExclusionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingCidrExclusionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exclusionResp, err := r.client.NetworkingCidrExclusionReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking cidr exclusion, got error: %s", err))
+		return
+	}
+
+	cidrExclusion := exclusionResp.JSON200.Exclusions[0]
+
+	// deleted CIDR exclusions are still returned by the API
+	if cidrExclusion.Status != nil && *cidrExclusion.Status == models.CidrExclusionStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking cidr exclusion", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	networkingCidrExclusionAPIToTFResource(cidrExclusion, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingCidrExclusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingCidrExclusionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update networking cidr exclusion", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err := r.client.NetworkingCidrExclusionUpdate(ctx, data.ID.ValueString(), models.NetworkingUpdateCidrExclusion{
+		Name:        aws.String(data.Name.ValueString()),
+		Description: data.Description.ValueStringPointer(),
+		Tags:        (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking cidr exclusion, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingCidrExclusion(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingCidrExclusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingCidrExclusionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking cidr exclusion", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err := r.client.NetworkingCidrExclusionDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking cidr exclusion, got error: %s", err))
+		return
+	}
+}
+
+func (r *NetworkingCidrExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingCidrExclusionResource) readNetworkingCidrExclusion(ctx context.Context, exclusionID string, data *NetworkingCidrExclusionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	exclusionResp, err := r.client.NetworkingCidrExclusionReadByID(ctx, exclusionID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking cidr exclusion, got error: %s", err))
+		return diags
+	}
+
+	networkingCidrExclusionAPIToTFResource(exclusionResp.JSON200.Exclusions[0], data)
+
+	return diags
+}
+
+func networkingCidrExclusionAPIToTFResource(cidrExclusion models.CidrExclusion, data *NetworkingCidrExclusionResourceModel) {
+	data.ID = types.StringPointerValue(cidrExclusion.Id)
+	data.NetworkingHubID = types.StringPointerValue(cidrExclusion.NetworkingHubId)
+	data.Name = types.StringValue(cidrExclusion.Name)
+	data.Description = types.StringPointerValue(cidrExclusion.Description)
+	data.Cidr = types.StringValue(cidrExclusion.Cidr)
+	data.Status = types.StringPointerValue((*string)(cidrExclusion.Status))
+
+	if cidrExclusion.Tags != nil && len(*cidrExclusion.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(cidrExclusion.Tags)))
+	}
+}
+
+// existingCidrExclusions returns the CIDRs of the exclusions of a networking hub by id, deleted exclusions are still
+// returned by the API but no longer use their CIDR so they are skipped.
+func existingCidrExclusions(exclusions []models.CidrExclusion) map[string]string {
+	existing := make(map[string]string)
+
+	for _, cidrExclusion := range exclusions {
+		if cidrExclusion.Status != nil && *cidrExclusion.Status == models.CidrExclusionStatusDELETED {
+			continue
+		}
+
+		existing[aws.ToString(cidrExclusion.Id)] = cidrExclusion.Cidr
+	}
+
+	return existing
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingCidrExclusionResource(t *testing.T) {
+
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	existingExclusionID := "0b6c5d4e-3f2a-4b1c-9d8e-7f6a5b4c3d2e"
+	exclusionID := "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"
+
+	si := mocks.NewServerInterface(t)
+
+	active := models.CidrExclusionStatusACTIVE
+
+	existing := models.CidrExclusion{
+		Id:              aws.String(existingExclusionID),
+		Name:            "on-premise",
+		Cidr:            "10.0.0.0/24",
+		NetworkingHubId: aws.String(hubID),
+		Status:          &active,
+	}
+
+	cidrExclusion := models.CidrExclusion{
+		Id:              aws.String(exclusionID),
+		Name:            "legacy",
+		Cidr:            "10.0.1.0/24",
+		NetworkingHubId: aws.String(hubID),
+		Status:          &active,
+		Tags:            &models.NetworkingTags{"CostCode": "12345"},
+	}
+
+	si.On("NetworkingReadHubCidrExclusions", mock.AnythingOfType("*echo.context"), hubID, mock.AnythingOfType("models.NetworkingReadHubCidrExclusionsParams")).Return(func(c echo.Context, hubID string, params models.NetworkingReadHubCidrExclusionsParams) error {
+		return c.JSON(200, &models.NetworkingReadCidrExclusions{Exclusions: []models.CidrExclusion{existing}})
+	})
+
+	si.On("NetworkingCreateCidrExclusion", mock.AnythingOfType("*echo.context"), hubID).Return(func(c echo.Context, hubID string) error {
+		ce := new(models.NetworkingCreateCidrExclusion)
+		if err := c.Bind(ce); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if ce.Cidr != "10.0.1.0/24" {
+			t.Errorf("unexpected cidr: %s", ce.Cidr)
+		}
+
+		return c.JSON(200, &models.NetworkingReadCidrExclusions{Exclusions: []models.CidrExclusion{cidrExclusion}})
+	})
+
+	si.On("NetworkingReadCidrExclusion", mock.AnythingOfType("*echo.context"), exclusionID, mock.AnythingOfType("models.NetworkingReadCidrExclusionParams")).Return(func(c echo.Context, exclusionID string, params models.NetworkingReadCidrExclusionParams) error {
+		return c.JSON(200, &models.NetworkingReadCidrExclusions{Exclusions: []models.CidrExclusion{cidrExclusion}})
+	})
+
+	si.On("NetworkingDeleteCidrExclusion", mock.AnythingOfType("*echo.context"), exclusionID).Return(func(c echo.Context, exclusionID string) error {
+		return c.JSON(200, &models.NetworkingReadCidrExclusions{Exclusions: []models.CidrExclusion{cidrExclusion}})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingCidrExclusionConfig("legacy", hubID, "10.0.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_cidr_exclusion.legacy", "id", exclusionID),
+					resource.TestCheckResourceAttr("stax_networking_cidr_exclusion.legacy", "cidr", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr("stax_networking_cidr_exclusion.legacy", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("stax_networking_cidr_exclusion.legacy", "tags.CostCode", "12345"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingCidrExclusionConfig(label, hubID, cidr string) string {
+	configTemplate := `
+resource "stax_networking_cidr_exclusion" "${label}" {
+	networking_hub_id = "${networking_hub_id}"
+	name              = "legacy"
+	cidr              = "${cidr}"
+	tags = {
+		CostCode = "12345"
+	}
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":             label,
+			"networking_hub_id": hubID,
+			"cidr":              cidr,
+		},
+	)
+}
+
+func TestNetworkingCidrExclusionResource_OverlapDeleted(t *testing.T) {
+	deleted := models.CidrExclusionStatusDELETED
+	active := models.CidrExclusionStatusACTIVE
+
+	existing := existingCidrExclusions([]models.CidrExclusion{
+		{Id: aws.String("0b6c5d4e-3f2a-4b1c-9d8e-7f6a5b4c3d2e"), Name: "replaced", Cidr: "10.0.0.0/16", Status: &deleted},
+		{Id: aws.String("2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"), Name: "default", Cidr: "10.1.0.0/16", Status: &active},
+	})
+
+	t.Run("ignores deleted exclusions", func(t *testing.T) {
+		diags := checkCidrOverlap("10.0.128.0/17", existing, "CIDR exclusion")
+		require.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("reports overlapping exclusions which aren't deleted", func(t *testing.T) {
+		diags := checkCidrOverlap("10.1.128.0/17", existing, "CIDR exclusion")
+		require.True(t, diags.HasError())
+		require.Equal(t, "Overlapping CIDR", diags[0].Summary())
+	})
+}
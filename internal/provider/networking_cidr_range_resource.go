@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingCidrRangeResource{}
+var _ resource.ResourceWithConfigure = &NetworkingCidrRangeResource{}
+var _ resource.ResourceWithImportState = &NetworkingCidrRangeResource{}
+
+type NetworkingCidrRangeResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	NetworkingHubID  types.String `tfsdk:"networking_hub_id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Cidr             types.String `tfsdk:"cidr"`
+	Tags             types.Map    `tfsdk:"tags"`
+	DefaultCidrRange types.Bool   `tfsdk:"default_cidr_range"`
+	Status           types.String `tfsdk:"status"`
+}
+
+func NewNetworkingCidrRangeResource() resource.Resource {
+	return &NetworkingCidrRangeResource{}
+}
+
+// NetworkingCidrRangeResource defines the resource implementation.
+type NetworkingCidrRangeResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingCidrRangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_cidr_range"
+}
+
+func (r *NetworkingCidrRangeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking CIDR range resource. The CIDRs of the VPCs in a networking hub are allocated from its CIDR ranges. The CIDR is checked against the existing CIDR ranges of the hub before it is created, as the CIDR ranges of a hub must not overlap.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "CIDR range identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"networking_hub_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the networking hub the CIDR range belongs to, changing this replaces the CIDR range",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the CIDR range",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the CIDR range",
+				Optional:            true,
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "The CIDR of the range in quad dot notation, a private network range between /8 and /23, changing this replaces the CIDR range",
+				Required:            true,
+				Validators: []validator.String{
+					cidrPrefixBetween(8, 23),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the CIDR range",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"default_cidr_range": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the default CIDR range of the networking hub",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the CIDR range",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NetworkingCidrRangeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingCidrRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingCidrRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rangesResp, err := r.client.NetworkingCidrRangeReadByHub(ctx, data.NetworkingHubID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking hub cidr ranges, got error: %s", err))
+		return
+	}
+
+	var existing map[string]string
+	if rangesResp.JSON200 != nil {
+		existing = existingCidrRanges(rangesResp.JSON200.Ranges)
+	}
+
+	resp.Diagnostics.Append(checkCidrOverlap(data.Cidr.ValueString(), existing, "CIDR range")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.NetworkingCidrRangeCreate(ctx, data.NetworkingHubID.ValueString(), models.NetworkingCreateCidrRange{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Cidr:        data.Cidr.ValueString(),
+		Tags:        (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking cidr range, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking cidr range create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	var rangeID string
+
+	if created.JSON200 != nil {
+		for _, cidrRange := range created.JSON200.Ranges {
+			if cidrRange.Cidr == data.Cidr.ValueString() && cidrRange.Id != nil {
+				rangeID = *cidrRange.Id
+				break
+			}
+		}
+	}
+
+	if rangeID == "" {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking cidr range, nil cidr range id in response")
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingCidrRange(ctx, rangeID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingCidrRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingCidrRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rangeResp, err := r.client.NetworkingCidrRangeReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking cidr range, got error: %s", err))
+		return
+	}
+
+	cidrRange := rangeResp.JSON200.Ranges[0]
+
+	// deleted CIDR ranges are still returned by the API
+	if cidrRange.Status != nil && *cidrRange.Status == models.CidrRangeStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking cidr range", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	networkingCidrRangeAPIToTFResource(cidrRange, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingCidrRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingCidrRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update networking cidr range", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err := r.client.NetworkingCidrRangeUpdate(ctx, data.ID.ValueString(), models.NetworkingUpdateCidrRange{
+		Name:        aws.String(data.Name.ValueString()),
+		Description: data.Description.ValueStringPointer(),
+		Tags:        (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking cidr range, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingCidrRange(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingCidrRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingCidrRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking cidr range", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err := r.client.NetworkingCidrRangeDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking cidr range, got error: %s", err))
+		return
+	}
+}
+
+func (r *NetworkingCidrRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingCidrRangeResource) readNetworkingCidrRange(ctx context.Context, rangeID string, data *NetworkingCidrRangeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rangeResp, err := r.client.NetworkingCidrRangeReadByID(ctx, rangeID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking cidr range, got error: %s", err))
+		return diags
+	}
+
+	networkingCidrRangeAPIToTFResource(rangeResp.JSON200.Ranges[0], data)
+
+	return diags
+}
+
+func networkingCidrRangeAPIToTFResource(cidrRange models.CidrRange, data *NetworkingCidrRangeResourceModel) {
+	data.ID = types.StringPointerValue(cidrRange.Id)
+	data.NetworkingHubID = types.StringPointerValue(cidrRange.NetworkingHubId)
+	data.Name = types.StringValue(cidrRange.Name)
+	data.Description = types.StringPointerValue(cidrRange.Description)
+	data.Cidr = types.StringValue(cidrRange.Cidr)
+	data.DefaultCidrRange = types.BoolValue(aws.ToBool(cidrRange.DefaultCidrRange))
+	data.Status = types.StringPointerValue((*string)(cidrRange.Status))
+
+	if cidrRange.Tags != nil && len(*cidrRange.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(cidrRange.Tags)))
+	}
+}
+
+// existingCidrRanges returns the CIDRs of the ranges of a networking hub by id, deleted ranges are still returned by the
+// API but no longer use their CIDR so they are skipped.
+func existingCidrRanges(ranges []models.CidrRange) map[string]string {
+	existing := make(map[string]string)
+
+	for _, cidrRange := range ranges {
+		if cidrRange.Status != nil && *cidrRange.Status == models.CidrRangeStatusDELETED {
+			continue
+		}
+
+		existing[aws.ToString(cidrRange.Id)] = cidrRange.Cidr
+	}
+
+	return existing
+}
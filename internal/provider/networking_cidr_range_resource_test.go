@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingCidrRangeResource(t *testing.T) {
+
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	defaultRangeID := "0b6c5d4e-3f2a-4b1c-9d8e-7f6a5b4c3d2e"
+	rangeID := "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"
+
+	si := mocks.NewServerInterface(t)
+
+	active := models.CidrRangeStatusACTIVE
+
+	defaultRange := models.CidrRange{
+		Id:               aws.String(defaultRangeID),
+		Name:             "default",
+		Cidr:             "10.0.0.0/16",
+		DefaultCidrRange: aws.Bool(true),
+		NetworkingHubId:  aws.String(hubID),
+		Status:           &active,
+	}
+
+	cidrRange := models.CidrRange{
+		Id:               aws.String(rangeID),
+		Name:             "workloads",
+		Cidr:             "10.1.0.0/16",
+		DefaultCidrRange: aws.Bool(false),
+		NetworkingHubId:  aws.String(hubID),
+		Status:           &active,
+		Tags:             &models.NetworkingTags{"CostCode": "12345"},
+	}
+
+	si.On("NetworkingReadHubCidrRanges", mock.AnythingOfType("*echo.context"), hubID, mock.AnythingOfType("models.NetworkingReadHubCidrRangesParams")).Return(func(c echo.Context, hubID string, params models.NetworkingReadHubCidrRangesParams) error {
+		return c.JSON(200, &models.NetworkingReadCidrRanges{Ranges: []models.CidrRange{defaultRange}})
+	})
+
+	si.On("NetworkingCreateCidrRange", mock.AnythingOfType("*echo.context"), hubID).Return(func(c echo.Context, hubID string) error {
+		cr := new(models.NetworkingCreateCidrRange)
+		if err := c.Bind(cr); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if cr.Cidr != "10.1.0.0/16" {
+			t.Errorf("unexpected cidr: %s", cr.Cidr)
+		}
+
+		return c.JSON(200, &models.NetworkingReadCidrRanges{Ranges: []models.CidrRange{cidrRange}})
+	})
+
+	si.On("NetworkingReadCidrRange", mock.AnythingOfType("*echo.context"), rangeID, mock.AnythingOfType("models.NetworkingReadCidrRangeParams")).Return(func(c echo.Context, rangeID string, params models.NetworkingReadCidrRangeParams) error {
+		return c.JSON(200, &models.NetworkingReadCidrRanges{Ranges: []models.CidrRange{cidrRange}})
+	})
+
+	si.On("NetworkingDeleteCidrRange", mock.AnythingOfType("*echo.context"), rangeID).Return(func(c echo.Context, rangeID string) error {
+		return c.JSON(200, &models.NetworkingReadCidrRanges{Ranges: []models.CidrRange{cidrRange}})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingCidrRangeConfig("workloads", hubID, "10.1.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_cidr_range.workloads", "id", rangeID),
+					resource.TestCheckResourceAttr("stax_networking_cidr_range.workloads", "cidr", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr("stax_networking_cidr_range.workloads", "default_cidr_range", "false"),
+					resource.TestCheckResourceAttr("stax_networking_cidr_range.workloads", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("stax_networking_cidr_range.workloads", "tags.CostCode", "12345"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestNetworkingCidrRangeResource_Overlap(t *testing.T) {
+
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+
+	si := mocks.NewServerInterface(t)
+
+	active := models.CidrRangeStatusACTIVE
+
+	si.On("NetworkingReadHubCidrRanges", mock.AnythingOfType("*echo.context"), hubID, mock.AnythingOfType("models.NetworkingReadHubCidrRangesParams")).Return(func(c echo.Context, hubID string, params models.NetworkingReadHubCidrRangesParams) error {
+		return c.JSON(200, &models.NetworkingReadCidrRanges{Ranges: []models.CidrRange{
+			{
+				Id:              aws.String("0b6c5d4e-3f2a-4b1c-9d8e-7f6a5b4c3d2e"),
+				Name:            "default",
+				Cidr:            "10.0.0.0/16",
+				NetworkingHubId: aws.String(hubID),
+				Status:          &active,
+			},
+		}})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckStaxNetworkingCidrRangeConfig("workloads", hubID, "10.0.128.0/17"),
+				ExpectError: regexp.MustCompile(`Overlapping CIDR`),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingCidrRangeConfig(label, hubID, cidr string) string {
+	configTemplate := `
+resource "stax_networking_cidr_range" "${label}" {
+	networking_hub_id = "${networking_hub_id}"
+	name              = "workloads"
+	cidr              = "${cidr}"
+	tags = {
+		CostCode = "12345"
+	}
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":             label,
+			"networking_hub_id": hubID,
+			"cidr":              cidr,
+		},
+	)
+}
+
+func TestNetworkingCidrRangeResource_OverlapDeleted(t *testing.T) {
+	deleted := models.CidrRangeStatusDELETED
+	active := models.CidrRangeStatusACTIVE
+
+	existing := existingCidrRanges([]models.CidrRange{
+		{Id: aws.String("0b6c5d4e-3f2a-4b1c-9d8e-7f6a5b4c3d2e"), Name: "replaced", Cidr: "10.0.0.0/16", Status: &deleted},
+		{Id: aws.String("2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"), Name: "default", Cidr: "10.1.0.0/16", Status: &active},
+	})
+
+	t.Run("ignores deleted ranges", func(t *testing.T) {
+		diags := checkCidrOverlap("10.0.128.0/17", existing, "CIDR range")
+		require.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("reports overlapping ranges which aren't deleted", func(t *testing.T) {
+		diags := checkCidrOverlap("10.1.128.0/17", existing, "CIDR range")
+		require.True(t, diags.HasError())
+		require.Equal(t, "Overlapping CIDR", diags[0].Summary())
+	})
+}
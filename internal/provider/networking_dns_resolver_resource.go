@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingDnsResolverResource{}
+var _ resource.ResourceWithConfigure = &NetworkingDnsResolverResource{}
+var _ resource.ResourceWithImportState = &NetworkingDnsResolverResource{}
+
+type NetworkingDnsResolverResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	NetworkingHubID     types.String   `tfsdk:"networking_hub_id"`
+	Name                types.String   `tfsdk:"name"`
+	NumberOfInterfaces  types.Int64    `tfsdk:"number_of_interfaces"`
+	Tags                types.Map      `tfsdk:"tags"`
+	InboundIPAddresses  types.List     `tfsdk:"inbound_ip_addresses"`
+	OutboundIPAddresses types.List     `tfsdk:"outbound_ip_addresses"`
+	Status              types.String   `tfsdk:"status"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewNetworkingDnsResolverResource() resource.Resource {
+	return &NetworkingDnsResolverResource{}
+}
+
+// NetworkingDnsResolverResource defines the resource implementation.
+type NetworkingDnsResolverResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingDnsResolverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_dns_resolver"
+}
+
+func (r *NetworkingDnsResolverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking DNS resolver resource. A DNS resolver creates Route53 inbound and outbound resolver endpoints in a networking hub, DNS rules are added to the resolver to forward queries for a domain to another network, such as an on premise DNS server.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS resolver identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"networking_hub_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the networking hub the DNS resolver is created in, changing this replaces the DNS resolver",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the DNS resolver",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"number_of_interfaces": schema.Int64Attribute{
+				MarkdownDescription: "The number of network interfaces attached to each of the resolver endpoints, between 2 and 6",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(2, 6),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the DNS resolver",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"inbound_ip_addresses": schema.ListAttribute{
+				MarkdownDescription: "The IP addresses of the inbound resolver endpoint, other networks forward queries for the networking hub domains to these addresses",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"outbound_ip_addresses": schema.ListAttribute{
+				MarkdownDescription: "The IP addresses of the outbound resolver endpoint, queries forwarded by DNS rules originate from these addresses",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the DNS resolver",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *NetworkingDnsResolverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingDnsResolverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingDnsResolverResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.NetworkingDnsResolverCreate(ctx, data.NetworkingHubID.ValueString(), models.NetworkingCreateDnsResolver{
+		Name:               data.Name.ValueString(),
+		NumberOfInterfaces: int(data.NumberOfInterfaces.ValueInt64()),
+		Tags:               (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking dns resolver, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking dns resolver create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	if created.JSON200 == nil || created.JSON200.Detail.DnsResolver.Id == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking dns resolver, nil dns resolver id in response")
+		return
+	}
+
+	resolverID := *created.JSON200.Detail.DnsResolver.Id
+
+	// save the id before waiting, so the dns resolver is still tracked in state if the task fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), resolverID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingDnsResolver(ctx, resolverID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingDnsResolverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingDnsResolverResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resolverResp, err := r.client.NetworkingDnsResolverReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking dns resolver, got error: %s", err))
+		return
+	}
+
+	resolver := resolverResp.JSON200.DnsResolvers[0]
+
+	// deleted DNS resolvers are still returned by the API
+	if resolver.Status != nil && *resolver.Status == models.DNSResolverStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking dns resolver", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	resp.Diagnostics.Append(networkingDnsResolverAPIToTFResource(ctx, resolver, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingDnsResolverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingDnsResolverResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update networking dns resolver", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updated, err := r.client.NetworkingDnsResolverUpdate(ctx, data.ID.ValueString(), models.NetworkingUpdateDnsResolver{
+		Name:               aws.String(data.Name.ValueString()),
+		NumberOfInterfaces: aws.Int(int(data.NumberOfInterfaces.ValueInt64())),
+		Tags:               (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking dns resolver, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, updated.Body, r.client, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingDnsResolver(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingDnsResolverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingDnsResolverResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking dns resolver", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.NetworkingDnsResolverDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking dns resolver, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func (r *NetworkingDnsResolverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingDnsResolverResource) readNetworkingDnsResolver(ctx context.Context, resolverID string, data *NetworkingDnsResolverResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resolverResp, err := r.client.NetworkingDnsResolverReadByID(ctx, resolverID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking dns resolver, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(networkingDnsResolverAPIToTFResource(ctx, resolverResp.JSON200.DnsResolvers[0], data)...)
+
+	return diags
+}
+
+func networkingDnsResolverAPIToTFResource(ctx context.Context, resolver models.DNSResolver, data *NetworkingDnsResolverResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringPointerValue(resolver.Id)
+	data.NetworkingHubID = types.StringValue(resolver.NetworkingHubId)
+	data.Name = types.StringValue(resolver.Name)
+	data.NumberOfInterfaces = types.Int64Value(int64(resolver.Interfaces))
+	data.Status = types.StringPointerValue((*string)(resolver.Status))
+
+	var d diag.Diagnostics
+	data.InboundIPAddresses, d = types.ListValueFrom(ctx, types.StringType, stringSliceOrEmpty(resolver.InboundIpAddresses))
+	diags.Append(d...)
+
+	data.OutboundIPAddresses, d = types.ListValueFrom(ctx, types.StringType, stringSliceOrEmpty(resolver.OutboundIpAddresses))
+	diags.Append(d...)
+
+	if resolver.Tags != nil && len(*resolver.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(resolver.Tags)))
+	}
+
+	return diags
+}
+
+// stringSliceOrEmpty returns the values, or an empty slice if the API returned null, so computed list attributes are
+// always known once the resource is read.
+func stringSliceOrEmpty(values *[]string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return *values
+}
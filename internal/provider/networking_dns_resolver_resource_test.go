@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingDnsResolverResource(t *testing.T) {
+
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	resolverID := "5d6e7f8a-9b0c-4d1e-8f2a-3b4c5d6e7f8a"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	message := models.MessageEventDetail{}
+	if err := message.FromMessageEventDetail0(models.MessageEventDetail0{TaskId: aws.String(taskID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	si.On("NetworkingCreateDnsResolver", mock.AnythingOfType("*echo.context"), hubID).Return(func(c echo.Context, hubID string) error {
+		cr := new(models.NetworkingCreateDnsResolver)
+		if err := c.Bind(cr); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if cr.NumberOfInterfaces != 2 {
+			t.Errorf("unexpected number of interfaces: %d", cr.NumberOfInterfaces)
+		}
+
+		created := &models.NetworkingCreateDnsResolverEvent{DetailType: "stax.networking.dns_resolver.create"}
+		created.Detail.Message = &message
+		created.Detail.OperationStatus = staxsdk.TaskStarted
+		created.Detail.DnsResolver.Id = aws.String(resolverID)
+
+		return c.JSON(200, created)
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("NetworkingReadDnsResolver", mock.AnythingOfType("*echo.context"), resolverID, mock.AnythingOfType("models.NetworkingReadDnsResolverParams")).Return(func(c echo.Context, resolverID string, params models.NetworkingReadDnsResolverParams) error {
+		status := models.DNSResolverStatusACTIVE
+
+		return c.JSON(200, &models.NetworkingReadDnsResolvers{
+			DnsResolvers: []models.DNSResolver{
+				{
+					Id:                  aws.String(resolverID),
+					Name:                "core-resolver",
+					NetworkingHubId:     hubID,
+					Interfaces:          2,
+					InboundIpAddresses:  &[]string{"10.0.0.10", "10.0.1.10"},
+					OutboundIpAddresses: &[]string{"10.0.0.20", "10.0.1.20"},
+					Status:              &status,
+					Tags:                &models.NetworkingTags{"CostCode": "12345"},
+				},
+			},
+		})
+	})
+
+	si.On("NetworkingDeleteDnsResolver", mock.AnythingOfType("*echo.context"), resolverID).Return(func(c echo.Context, resolverID string) error {
+		deleted := &models.NetworkingDeleteDnsResolverEvent{DetailType: "stax.networking.dns_resolver.delete"}
+		deleted.Detail.Message = &message
+		deleted.Detail.OperationStatus = staxsdk.TaskStarted
+
+		return c.JSON(200, deleted)
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingDnsResolverConfig("core", hubID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_dns_resolver.core", "id", resolverID),
+					resource.TestCheckResourceAttr("stax_networking_dns_resolver.core", "number_of_interfaces", "2"),
+					resource.TestCheckResourceAttr("stax_networking_dns_resolver.core", "inbound_ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("stax_networking_dns_resolver.core", "outbound_ip_addresses.0", "10.0.0.20"),
+					resource.TestCheckResourceAttr("stax_networking_dns_resolver.core", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("stax_networking_dns_resolver.core", "tags.CostCode", "12345"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingDnsResolverConfig(label, hubID string) string {
+	configTemplate := `
+resource "stax_networking_dns_resolver" "${label}" {
+	networking_hub_id    = "${networking_hub_id}"
+	name                 = "core-resolver"
+	number_of_interfaces = 2
+	tags = {
+		CostCode = "12345"
+	}
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":             label,
+			"networking_hub_id": hubID,
+		},
+	)
+}
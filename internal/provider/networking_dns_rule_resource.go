@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingDnsRuleResource{}
+var _ resource.ResourceWithConfigure = &NetworkingDnsRuleResource{}
+var _ resource.ResourceWithImportState = &NetworkingDnsRuleResource{}
+
+type NetworkingDnsRuleResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	DnsResolverID        types.String   `tfsdk:"dns_resolver_id"`
+	Name                 types.String   `tfsdk:"name"`
+	DomainName           types.String   `tfsdk:"domain_name"`
+	ForwarderIPAddresses types.Set      `tfsdk:"forwarder_ip_addresses"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	AwsRuleID            types.String   `tfsdk:"aws_rule_id"`
+	Status               types.String   `tfsdk:"status"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewNetworkingDnsRuleResource() resource.Resource {
+	return &NetworkingDnsRuleResource{}
+}
+
+// NetworkingDnsRuleResource defines the resource implementation.
+type NetworkingDnsRuleResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingDnsRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_dns_rule"
+}
+
+func (r *NetworkingDnsRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking DNS rule resource. A DNS rule forwards the queries for a domain from the outbound endpoint of a DNS resolver to a set of DNS servers, such as on premise DNS servers. The domain is checked against the existing DNS rules of the resolver before it is created, as a resolver can only have one rule for each domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_resolver_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the DNS resolver the DNS rule is added to, changing this replaces the DNS rule",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the DNS rule",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				MarkdownDescription: "The domain name to forward DNS queries for, such as `corp.example.com`, changing this replaces the DNS rule",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"forwarder_ip_addresses": schema.SetAttribute{
+				MarkdownDescription: "The IPv4 addresses of the DNS servers to forward the DNS queries to",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 6),
+					setvalidator.ValueStringsAre(ipv4Address()),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the DNS rule",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"aws_rule_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the AWS Route53 resolver rule",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the DNS rule",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *NetworkingDnsRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingDnsRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingDnsRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	forwarderIPAddresses := make([]string, 0)
+	resp.Diagnostics.Append(data.ForwarderIPAddresses.ElementsAs(ctx, &forwarderIPAddresses, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesResp, err := r.client.NetworkingDnsRuleReadByResolver(ctx, data.DnsResolverID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking dns resolver rules, got error: %s", err))
+		return
+	}
+
+	if rulesResp.JSON200 != nil {
+		for _, rule := range rulesResp.JSON200.DnsRules {
+			if normaliseDomainName(rule.DomainName) == normaliseDomainName(data.DomainName.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("domain_name"),
+					"Duplicate DNS Rule",
+					fmt.Sprintf("The DNS resolver already has the DNS rule %s (%s) for the domain %s.", aws.ToString(rule.Id), rule.Name, rule.DomainName),
+				)
+				return
+			}
+		}
+	}
+
+	created, err := r.client.NetworkingDnsRuleCreate(ctx, data.DnsResolverID.ValueString(), models.NetworkingCreateDnsRule{
+		Name:                 data.Name.ValueString(),
+		DomainName:           data.DomainName.ValueString(),
+		ForwarderIpAddresses: forwarderIPAddresses,
+		Tags:                 (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking dns rule, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking dns rule create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	if created.JSON200 == nil || created.JSON200.Detail.DnsRule.Id == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking dns rule, nil dns rule id in response")
+		return
+	}
+
+	ruleID := *created.JSON200.Detail.DnsRule.Id
+
+	// save the id before waiting, so the dns rule is still tracked in state if the task fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingDnsRule(ctx, ruleID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingDnsRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingDnsRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleResp, err := r.client.NetworkingDnsRuleReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking dns rule, got error: %s", err))
+		return
+	}
+
+	rule := ruleResp.JSON200.DnsRules[0]
+
+	// deleted DNS rules are still returned by the API
+	if rule.Status != nil && *rule.Status == models.DNSRuleStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking dns rule", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	resp.Diagnostics.Append(networkingDnsRuleAPIToTFResource(ctx, rule, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingDnsRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingDnsRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	forwarderIPAddresses := make([]string, 0)
+	resp.Diagnostics.Append(data.ForwarderIPAddresses.ElementsAs(ctx, &forwarderIPAddresses, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update networking dns rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updated, err := r.client.NetworkingDnsRuleUpdate(ctx, data.ID.ValueString(), models.NetworkingUpdateDnsRule{
+		Name:                 aws.String(data.Name.ValueString()),
+		ForwarderIpAddresses: &forwarderIPAddresses,
+		Tags:                 (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking dns rule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, updated.Body, r.client, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingDnsRule(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingDnsRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingDnsRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking dns rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.NetworkingDnsRuleDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking dns rule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func (r *NetworkingDnsRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingDnsRuleResource) readNetworkingDnsRule(ctx context.Context, ruleID string, data *NetworkingDnsRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ruleResp, err := r.client.NetworkingDnsRuleReadByID(ctx, ruleID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking dns rule, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(networkingDnsRuleAPIToTFResource(ctx, ruleResp.JSON200.DnsRules[0], data)...)
+
+	return diags
+}
+
+func networkingDnsRuleAPIToTFResource(ctx context.Context, rule models.DNSRule, data *NetworkingDnsRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringPointerValue(rule.Id)
+	data.Name = types.StringValue(rule.Name)
+	data.AwsRuleID = types.StringPointerValue(rule.AwsRuleId)
+	data.Status = types.StringPointerValue((*string)(rule.Status))
+
+	if rule.DnsResolverId != nil {
+		data.DnsResolverID = types.StringValue(*rule.DnsResolverId)
+	}
+
+	// keep the configured domain name when it only differs by case or the trailing dot
+	if normaliseDomainName(rule.DomainName) != normaliseDomainName(data.DomainName.ValueString()) {
+		data.DomainName = types.StringValue(rule.DomainName)
+	}
+
+	var d diag.Diagnostics
+	data.ForwarderIPAddresses, d = types.SetValueFrom(ctx, types.StringType, rule.ForwarderIpAddresses)
+	diags.Append(d...)
+
+	if rule.Tags != nil && len(*rule.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(rule.Tags)))
+	}
+
+	return diags
+}
+
+// normaliseDomainName returns the domain name in lower case without the trailing dot, so "Corp.Example.com." and
+// "corp.example.com" are treated as the same domain.
+func normaliseDomainName(domainName string) string {
+	return strings.TrimSuffix(strings.ToLower(domainName), ".")
+}
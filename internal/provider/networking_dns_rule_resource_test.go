@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingDnsRuleResource(t *testing.T) {
+
+	resolverID := "5d6e7f8a-9b0c-4d1e-8f2a-3b4c5d6e7f8a"
+	ruleID := "8e9f0a1b-2c3d-4e4f-9a5b-6c7d8e9f0a1b"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	message := models.MessageEventDetail{}
+	if err := message.FromMessageEventDetail0(models.MessageEventDetail0{TaskId: aws.String(taskID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	si.On("NetworkingReadResolverDnsRules", mock.AnythingOfType("*echo.context"), resolverID, mock.AnythingOfType("models.NetworkingReadResolverDnsRulesParams")).Return(func(c echo.Context, resolverID string, params models.NetworkingReadResolverDnsRulesParams) error {
+		return c.JSON(200, &models.NetworkingReadDnsRules{DnsRules: []models.DNSRule{}})
+	})
+
+	si.On("NetworkingCreateDnsRule", mock.AnythingOfType("*echo.context"), resolverID).Return(func(c echo.Context, resolverID string) error {
+		cr := new(models.NetworkingCreateDnsRule)
+		if err := c.Bind(cr); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if cr.DomainName != "corp.example.com" {
+			t.Errorf("unexpected domain name: %s", cr.DomainName)
+		}
+
+		created := &models.NetworkingCreateDnsRuleEvent{DetailType: "stax.networking.dns_rule.create"}
+		created.Detail.Message = &message
+		created.Detail.OperationStatus = staxsdk.TaskStarted
+		created.Detail.DnsRule.Id = aws.String(ruleID)
+
+		return c.JSON(200, created)
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("NetworkingReadDnsRule", mock.AnythingOfType("*echo.context"), ruleID, mock.AnythingOfType("models.NetworkingReadDnsRuleParams")).Return(func(c echo.Context, ruleID string, params models.NetworkingReadDnsRuleParams) error {
+		status := models.DNSRuleStatusACTIVE
+
+		return c.JSON(200, &models.NetworkingReadDnsRules{
+			DnsRules: []models.DNSRule{
+				{
+					Id:                   aws.String(ruleID),
+					DnsResolverId:        aws.String(resolverID),
+					Name:                 "on-premise",
+					DomainName:           "corp.example.com",
+					ForwarderIpAddresses: []string{"192.168.0.2", "192.168.0.3"},
+					AwsRuleId:            aws.String("rslvr-rr-0123456789abcdef0"),
+					Status:               &status,
+				},
+			},
+		})
+	})
+
+	si.On("NetworkingDeleteDnsRule", mock.AnythingOfType("*echo.context"), ruleID).Return(func(c echo.Context, ruleID string) error {
+		deleted := &models.NetworkingDeleteDnsRuleEvent{DetailType: "stax.networking.dns_rule.delete"}
+		deleted.Detail.Message = &message
+		deleted.Detail.OperationStatus = staxsdk.TaskStarted
+
+		return c.JSON(200, deleted)
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingDnsRuleConfig("on_premise", resolverID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_dns_rule.on_premise", "id", ruleID),
+					resource.TestCheckResourceAttr("stax_networking_dns_rule.on_premise", "domain_name", "corp.example.com"),
+					resource.TestCheckResourceAttr("stax_networking_dns_rule.on_premise", "forwarder_ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("stax_networking_dns_rule.on_premise", "aws_rule_id", "rslvr-rr-0123456789abcdef0"),
+					resource.TestCheckResourceAttr("stax_networking_dns_rule.on_premise", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingDnsRuleConfig(label, resolverID string) string {
+	configTemplate := `
+resource "stax_networking_dns_rule" "${label}" {
+	dns_resolver_id        = "${dns_resolver_id}"
+	name                   = "on-premise"
+	domain_name            = "corp.example.com"
+	forwarder_ip_addresses = ["192.168.0.2", "192.168.0.3"]
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":           label,
+			"dns_resolver_id": resolverID,
+		},
+	)
+}
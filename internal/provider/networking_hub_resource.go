@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingHubResource{}
+var _ resource.ResourceWithConfigure = &NetworkingHubResource{}
+var _ resource.ResourceWithImportState = &NetworkingHubResource{}
+
+type NetworkingHubResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	Description           types.String   `tfsdk:"description"`
+	AccountID             types.String   `tfsdk:"account_id"`
+	Region                types.String   `tfsdk:"region"`
+	Cidr                  types.String   `tfsdk:"cidr"`
+	AmazonSideAsn         types.Int64    `tfsdk:"amazon_side_asn"`
+	PhzSuffix             types.String   `tfsdk:"phz_suffix"`
+	CreateInternetGateway types.Bool     `tfsdk:"create_internet_gateway"`
+	CreateNatGateway      types.Bool     `tfsdk:"create_nat_gateway"`
+	Tags                  types.Map      `tfsdk:"tags"`
+	Status                types.String   `tfsdk:"status"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewNetworkingHubResource() resource.Resource {
+	return &NetworkingHubResource{}
+}
+
+// NetworkingHubResource defines the resource implementation.
+type NetworkingHubResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingHubResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_hub"
+}
+
+func (r *NetworkingHubResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking hub resource. Stax Networking Hubs provide a transit VPC and gateway in an AWS account and region, which the VPCs of the hub are connected to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Networking hub identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the networking hub",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the networking hub",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the stax account the networking hub is deployed to, changing this replaces the networking hub",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region the networking hub is deployed to, changing this replaces the networking hub",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "The CIDR range of the transit VPC, a private network range between /8 and /22, changing this replaces the networking hub. " +
+					"Stax doesn't return this value, so after an import it is set from the configuration without replacing the networking hub",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfStateSet,
+						"Changing the CIDR replaces the networking hub",
+						"Changing the CIDR replaces the networking hub",
+					),
+				},
+			},
+			"amazon_side_asn": schema.Int64Attribute{
+				MarkdownDescription: "A private Autonomous System Number (ASN) for the Amazon side of a BGP session, changing this replaces the networking hub",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"phz_suffix": schema.StringAttribute{
+				MarkdownDescription: "The suffix used to name the Route53 Private Hosted Zones of the networking hub, this can't be modified once set",
+				Optional:            true,
+			},
+			"create_internet_gateway": schema.BoolAttribute{
+				MarkdownDescription: "Create an Internet Gateway in the transit VPC, defaults to `false`. Stax doesn't return this value, so it is null after an import and the existing gateway is left unchanged while it isn't set",
+				Optional:            true,
+			},
+			"create_nat_gateway": schema.BoolAttribute{
+				MarkdownDescription: "Create a NAT Gateway in the transit VPC, defaults to `false`. Stax doesn't return this value, so it is null after an import and the existing gateway is left unchanged while it isn't set",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the networking hub",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the networking hub",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *NetworkingHubResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingHubResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingHubResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createHub := models.NetworkingCreateHub{
+		Name:                  data.Name.ValueString(),
+		Description:           data.Description.ValueStringPointer(),
+		AccountId:             data.AccountID.ValueString(),
+		Region:                models.AwsRegion(data.Region.ValueString()),
+		Cidr:                  data.Cidr.ValueString(),
+		PhzSuffix:             data.PhzSuffix.ValueStringPointer(),
+		CreateInternetGateway: data.CreateInternetGateway.ValueBool(),
+		CreateNatGateway:      data.CreateNatGateway.ValueBool(),
+		Tags:                  (*models.NetworkingTags)(&staxTags),
+	}
+
+	if !data.AmazonSideAsn.IsUnknown() && !data.AmazonSideAsn.IsNull() {
+		createHub.AmazonSideAsn = aws.Int(int(data.AmazonSideAsn.ValueInt64()))
+	}
+
+	created, err := r.client.NetworkingHubCreate(ctx, createHub)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking hub, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking hub create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	if created.JSON200 == nil || created.JSON200.Detail.NetworkingHub.Id == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking hub, nil networking hub id in response")
+		return
+	}
+
+	hubID := *created.JSON200.Detail.NetworkingHub.Id
+
+	// save the id before waiting, so the networking hub is still tracked in state if the task fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), hubID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingHub(ctx, hubID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingHubResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingHubResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubResp, err := r.client.NetworkingHubReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking hub, got error: %s", err))
+		return
+	}
+
+	hub := hubResp.JSON200.Hubs[0]
+
+	// deleted networking hubs are still returned by the API
+	if hub.Status != nil && *hub.Status == models.NetworkingHubStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking hub", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	networkingHubAPIToTFResource(hub, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingHubResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingHubResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateHub := models.NetworkingUpdateHub{
+		Name:                  aws.String(data.Name.ValueString()),
+		Description:           data.Description.ValueStringPointer(),
+		PhzSuffix:             data.PhzSuffix.ValueStringPointer(),
+		CreateInternetGateway: knownBoolPointer(data.CreateInternetGateway),
+		CreateNatGateway:      knownBoolPointer(data.CreateNatGateway),
+		Tags:                  (*models.NetworkingTags)(&staxTags),
+	}
+
+	tflog.Info(ctx, "update networking hub", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updated, err := r.client.NetworkingHubUpdate(ctx, data.ID.ValueString(), updateHub)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking hub, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, updated.Body, r.client, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingHub(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingHubResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingHubResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking hub", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.NetworkingHubDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking hub, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func (r *NetworkingHubResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingHubResource) readNetworkingHub(ctx context.Context, hubID string, data *NetworkingHubResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	hubResp, err := r.client.NetworkingHubReadByID(ctx, hubID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking hub, got error: %s", err))
+		return diags
+	}
+
+	networkingHubAPIToTFResource(hubResp.JSON200.Hubs[0], data)
+
+	return diags
+}
+
+// networkingHubAPIToTFResource copies the networking hub into the resource model. The API doesn't return the CIDR or
+// gateway settings of the hub, so these keep the values already in the model.
+func networkingHubAPIToTFResource(hub models.NetworkingHub, data *NetworkingHubResourceModel) {
+	data.ID = types.StringPointerValue(hub.Id)
+	data.Name = types.StringValue(hub.Name)
+	data.Description = types.StringPointerValue(hub.Description)
+	data.AccountID = types.StringValue(hub.AccountId)
+	data.Region = types.StringValue(string(hub.Region))
+	data.PhzSuffix = types.StringPointerValue(hub.PhzSuffix)
+	data.Status = types.StringPointerValue((*string)(hub.Status))
+
+	if hub.Asn != nil {
+		data.AmazonSideAsn = types.Int64Value(int64(*hub.Asn))
+	} else {
+		data.AmazonSideAsn = types.Int64Null()
+	}
+
+	if hub.Tags != nil && len(*hub.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(hub.Tags)))
+	}
+}
+
+// requiresReplaceIfStateSet requires replacement when a value already in the state changes, values which are null in
+// the state, for example attributes the API doesn't return after an import, are set without replacing the resource.
+func requiresReplaceIfStateSet(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
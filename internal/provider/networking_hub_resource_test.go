@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingHubResource(t *testing.T) {
+
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	accountID := "f646e0cf-840c-401a-933c-1ef3432b5a37"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	message := models.MessageEventDetail{}
+	if err := message.FromMessageEventDetail0(models.MessageEventDetail0{TaskId: aws.String(taskID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	si.On("NetworkingCreateHub", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		ch := new(models.NetworkingCreateHub)
+		if err := c.Bind(ch); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if ch.Cidr != "10.0.0.0/16" {
+			t.Errorf("unexpected cidr: %s", ch.Cidr)
+		}
+
+		created := &models.NetworkingCreateHubEvent{DetailType: "stax.networking.hub.create"}
+		created.Detail.Message = &message
+		created.Detail.OperationStatus = staxsdk.TaskStarted
+		created.Detail.NetworkingHub.Id = aws.String(hubID)
+
+		return c.JSON(200, created)
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("NetworkingReadHub", mock.AnythingOfType("*echo.context"), hubID, mock.AnythingOfType("models.NetworkingReadHubParams")).Return(func(c echo.Context, hubID string, params models.NetworkingReadHubParams) error {
+		status := models.NetworkingHubStatusACTIVE
+
+		return c.JSON(200, &models.NetworkingReadHubs{
+			Hubs: []models.NetworkingHub{
+				{
+					Id:        aws.String(hubID),
+					Name:      "core-hub",
+					AccountId: accountID,
+					Region:    "ap-southeast-2",
+					Asn:       aws.Int(64512),
+					Status:    &status,
+					Tags:      &models.NetworkingTags{"CostCode": "12345"},
+				},
+			},
+		})
+	})
+
+	si.On("NetworkingDeleteHub", mock.AnythingOfType("*echo.context"), hubID).Return(func(c echo.Context, hubID string) error {
+		return c.JSON(200, map[string]interface{}{
+			"DetailType": "stax.networking.hub.delete",
+			"Detail":     &models.BaseEventDetail{Message: &message, OperationStatus: staxsdk.TaskStarted},
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingHubConfig("core", accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_hub.core", "id", hubID),
+					resource.TestCheckResourceAttr("stax_networking_hub.core", "cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("stax_networking_hub.core", "amazon_side_asn", "64512"),
+					resource.TestCheckResourceAttr("stax_networking_hub.core", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("stax_networking_hub.core", "tags.CostCode", "12345"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "stax_networking_hub.core",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API doesn't return the CIDR or gateway settings of the hub
+				ImportStateVerifyIgnore: []string{"cidr", "create_internet_gateway", "create_nat_gateway", "timeouts"},
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingHubConfig(label, accountID string) string {
+	configTemplate := `
+resource "stax_networking_hub" "${label}" {
+	name       = "core-hub"
+	account_id = "${account_id}"
+	region     = "ap-southeast-2"
+	cidr       = "10.0.0.0/16"
+	tags = {
+		CostCode = "12345"
+	}
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":      label,
+			"account_id": accountID,
+		},
+	)
+}
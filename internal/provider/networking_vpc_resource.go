@@ -0,0 +1,586 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingVpcResource{}
+var _ resource.ResourceWithConfigure = &NetworkingVpcResource{}
+var _ resource.ResourceWithImportState = &NetworkingVpcResource{}
+
+type NetworkingVpcResourceModel struct {
+	ID                          types.String   `tfsdk:"id"`
+	NetworkingHubID             types.String   `tfsdk:"networking_hub_id"`
+	Name                        types.String   `tfsdk:"name"`
+	Description                 types.String   `tfsdk:"description"`
+	AccountID                   types.String   `tfsdk:"account_id"`
+	Region                      types.String   `tfsdk:"region"`
+	CidrRangeID                 types.String   `tfsdk:"cidr_range_id"`
+	Size                        types.String   `tfsdk:"size"`
+	Type                        types.String   `tfsdk:"type"`
+	Zone                        types.String   `tfsdk:"zone"`
+	CreateInternetGateway       types.Bool     `tfsdk:"create_internet_gateway"`
+	CreateVirtualPrivateGateway types.Bool     `tfsdk:"create_virtual_private_gateway"`
+	VirtualPrivateGatewayAsn    types.Int64    `tfsdk:"virtual_private_gateway_asn"`
+	CreateFlowLogs              types.Bool     `tfsdk:"create_flow_logs"`
+	GatewayEndpoints            types.Set      `tfsdk:"gateway_endpoints"`
+	PhzPrefix                   types.String   `tfsdk:"phz_prefix"`
+	Tags                        types.Map      `tfsdk:"tags"`
+	Cidr                        types.String   `tfsdk:"cidr"`
+	AwsVpcID                    types.String   `tfsdk:"aws_vpc_id"`
+	Status                      types.String   `tfsdk:"status"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewNetworkingVpcResource() resource.Resource {
+	return &NetworkingVpcResource{}
+}
+
+// NetworkingVpcResource defines the resource implementation.
+type NetworkingVpcResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingVpcResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_vpc"
+}
+
+func (r *NetworkingVpcResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking VPC resource. Stax VPCs are created in a Stax account and connected to a networking hub, their CIDR is allocated from a CIDR range of the hub.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "VPC identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"networking_hub_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the networking hub the VPC is connected to, changing this replaces the VPC",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the VPC",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the VPC",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the stax account the VPC is created in, changing this replaces the VPC",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region the VPC is created in, changing this replaces the VPC",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr_range_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the networking hub CIDR range the VPC CIDR is allocated from, changing this replaces the VPC",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				MarkdownDescription: "The size of the VPC, this can be `SMALL`, `MEDIUM` or `LARGE`, changing this replaces the VPC",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(models.NetworkingCreateVpcSizeSMALL),
+						string(models.NetworkingCreateVpcSizeMEDIUM),
+						string(models.NetworkingCreateVpcSizeLARGE),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the VPC, which determines the route tables attached to it, this can be `FLAT`, `ISOLATED` or `SHAREDSERVICES`, changing this replaces the VPC",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(models.NetworkingCreateVpcTypeFLAT),
+						string(models.NetworkingCreateVpcTypeISOLATED),
+						string(models.NetworkingCreateVpcTypeSHAREDSERVICES),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The zone of the VPC, `FLAT` VPCs in the same zone can communicate with each other, changing this replaces the VPC",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_internet_gateway": schema.BoolAttribute{
+				MarkdownDescription: "Create an Internet Gateway in the VPC, defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_virtual_private_gateway": schema.BoolAttribute{
+				MarkdownDescription: "Create a Virtual Private Gateway in the VPC",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_private_gateway_asn": schema.Int64Attribute{
+				MarkdownDescription: "The ASN assigned to the Virtual Private Gateway",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_flow_logs": schema.BoolAttribute{
+				MarkdownDescription: "Send the VPC flow logs to a CloudWatch log group",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gateway_endpoints": schema.SetAttribute{
+				MarkdownDescription: "The gateway VPC endpoints created in the VPC, this can include `s3` and `dynamodb`",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							string(models.S3),
+							string(models.Dynamodb),
+						),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phz_prefix": schema.StringAttribute{
+				MarkdownDescription: "The unique prefix combined with the networking hub `phz_suffix` to name the Route53 Private Hosted Zone of the VPC, this can't be modified once set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the VPC",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "The CIDR allocated to the VPC from the CIDR range",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_vpc_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS identifier of the VPC",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the VPC",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *NetworkingVpcResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingVpcResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingVpcResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	gatewayEndpoints, diags := networkingGatewayEndpointsTFToAPI(ctx, data.GatewayEndpoints)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createVpc := models.NetworkingCreateVpc{
+		Name:                        data.Name.ValueString(),
+		Description:                 data.Description.ValueStringPointer(),
+		AccountId:                   data.AccountID.ValueString(),
+		Region:                      models.AwsRegion(data.Region.ValueString()),
+		CidrRangeId:                 data.CidrRangeID.ValueString(),
+		Size:                        models.NetworkingCreateVpcSize(data.Size.ValueString()),
+		Type:                        models.NetworkingCreateVpcType(data.Type.ValueString()),
+		Zone:                        knownStringPointer(data.Zone),
+		CreateInternetGateway:       data.CreateInternetGateway.ValueBool(),
+		CreateVirtualPrivateGateway: knownBoolPointer(data.CreateVirtualPrivateGateway),
+		VirtualPrivateGatewayAsn:    knownIntPointer(data.VirtualPrivateGatewayAsn),
+		CreateCloudwatchVpcFlowlogs: knownBoolPointer(data.CreateFlowLogs),
+		GatewayEndpoints:            gatewayEndpoints,
+		PhzPrefix:                   knownStringPointer(data.PhzPrefix),
+		Tags:                        (*models.NetworkingTags)(&staxTags),
+	}
+
+	created, err := r.client.NetworkingVpcCreate(ctx, data.NetworkingHubID.ValueString(), createVpc)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking vpc, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking vpc create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	if created.JSON200 == nil || created.JSON200.Detail.VPC.Id == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking vpc, nil vpc id in response")
+		return
+	}
+
+	vpcID := *created.JSON200.Detail.VPC.Id
+
+	// save the id before waiting, so the vpc is still tracked in state if the task fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vpcID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingVpc(ctx, vpcID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingVpcResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpcResp, err := r.client.NetworkingVpcReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking vpc, got error: %s", err))
+		return
+	}
+
+	vpc := vpcResp.JSON200.Vpcs[0]
+
+	// deleted VPCs are still returned by the API
+	if vpc.Status != nil && *vpc.Status == models.VPCStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking vpc", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	resp.Diagnostics.Append(networkingVpcAPIToTFResource(ctx, vpc, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpcResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingVpcResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	gatewayEndpoints, diags := networkingGatewayEndpointsTFToAPI(ctx, data.GatewayEndpoints)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateVpc := models.NetworkingUpdateVpc{
+		Name:                        aws.String(data.Name.ValueString()),
+		Description:                 data.Description.ValueStringPointer(),
+		CreateInternetGateway:       knownBoolPointer(data.CreateInternetGateway),
+		CreateVirtualPrivateGateway: knownBoolPointer(data.CreateVirtualPrivateGateway),
+		VirtualPrivateGatewayAsn:    knownIntPointer(data.VirtualPrivateGatewayAsn),
+		CreateCloudwatchVpcFlowlogs: knownBoolPointer(data.CreateFlowLogs),
+		GatewayEndpoints:            gatewayEndpoints,
+		PhzPrefix:                   knownStringPointer(data.PhzPrefix),
+		Tags:                        (*models.NetworkingTags)(&staxTags),
+	}
+
+	tflog.Info(ctx, "update networking vpc", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updated, err := r.client.NetworkingVpcUpdate(ctx, data.ID.ValueString(), updateVpc)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking vpc, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, updated.Body, r.client, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingVpc(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpcResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingVpcResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking vpc", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.NetworkingVpcDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking vpc, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func (r *NetworkingVpcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingVpcResource) readNetworkingVpc(ctx context.Context, vpcID string, data *NetworkingVpcResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vpcResp, err := r.client.NetworkingVpcReadByID(ctx, vpcID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking vpc, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(networkingVpcAPIToTFResource(ctx, vpcResp.JSON200.Vpcs[0], data)...)
+
+	return diags
+}
+
+func networkingVpcAPIToTFResource(ctx context.Context, vpc models.VPC, data *NetworkingVpcResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringPointerValue(vpc.Id)
+	data.NetworkingHubID = types.StringPointerValue(vpc.NetworkingHubId)
+	data.Name = types.StringValue(vpc.Name)
+	data.Description = types.StringPointerValue(vpc.Description)
+	data.AccountID = types.StringValue(vpc.AccountId)
+	data.Region = types.StringValue(string(vpc.Region))
+	data.CidrRangeID = types.StringPointerValue(vpc.CidrRangeId)
+	data.Size = types.StringPointerValue((*string)(vpc.Size))
+	data.Type = types.StringPointerValue((*string)(vpc.Type))
+	data.Zone = types.StringPointerValue(vpc.Zone)
+	data.CreateInternetGateway = types.BoolValue(aws.ToBool(vpc.CreateIgw))
+	data.CreateVirtualPrivateGateway = types.BoolValue(aws.ToBool(vpc.CreateVgw))
+	data.CreateFlowLogs = types.BoolValue(aws.ToBool(vpc.CreateFlowlogCwl))
+	data.PhzPrefix = types.StringPointerValue(vpc.PhzPrefix)
+	data.Cidr = types.StringPointerValue(vpc.Cidr)
+	data.AwsVpcID = types.StringPointerValue(vpc.AwsVpcId)
+	data.Status = types.StringPointerValue((*string)(vpc.Status))
+
+	if vpc.VgwAsn != nil {
+		data.VirtualPrivateGatewayAsn = types.Int64Value(int64(*vpc.VgwAsn))
+	} else {
+		data.VirtualPrivateGatewayAsn = types.Int64Null()
+	}
+
+	gatewayEndpoints := make([]string, 0)
+	if vpc.GatewayEndpoints != nil {
+		for _, endpoint := range *vpc.GatewayEndpoints {
+			gatewayEndpoints = append(gatewayEndpoints, string(endpoint))
+		}
+	}
+
+	var d diag.Diagnostics
+	data.GatewayEndpoints, d = types.SetValueFrom(ctx, types.StringType, gatewayEndpoints)
+	diags.Append(d...)
+
+	if vpc.Tags != nil && len(*vpc.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(vpc.Tags)))
+	}
+
+	return diags
+}
+
+func networkingGatewayEndpointsTFToAPI(ctx context.Context, gatewayEndpoints types.Set) (*[]models.GatewayEndpoint, diag.Diagnostics) {
+	if gatewayEndpoints.IsNull() || gatewayEndpoints.IsUnknown() {
+		return nil, nil
+	}
+
+	values := make([]string, 0)
+
+	diags := gatewayEndpoints.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	endpoints := make([]models.GatewayEndpoint, 0, len(values))
+	for _, value := range values {
+		endpoints = append(endpoints, models.GatewayEndpoint(value))
+	}
+
+	return &endpoints, diags
+}
+
+// knownStringPointer returns a pointer to the value, or nil if it is null or unknown, this is used for optional
+// attributes which are computed by Stax when they aren't configured.
+func knownStringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return aws.String(value.ValueString())
+}
+
+func knownBoolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return aws.Bool(value.ValueBool())
+}
+
+func knownIntPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return aws.Int(int(value.ValueInt64()))
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingVpcResource(t *testing.T) {
+
+	vpcID := "2b6f8d0e-1c3a-4e5f-9b7d-8c0a2e4f6b13"
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	cidrRangeID := "4d8a0c2e-6b1f-4a3d-9e5c-7f9b1d3a5c24"
+	accountID := "f646e0cf-840c-401a-933c-1ef3432b5a37"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	message := models.MessageEventDetail{}
+	if err := message.FromMessageEventDetail0(models.MessageEventDetail0{TaskId: aws.String(taskID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	si.On("NetworkingCreateVpc", mock.AnythingOfType("*echo.context"), hubID).Return(func(c echo.Context, hubID string) error {
+		cv := new(models.NetworkingCreateVpc)
+		if err := c.Bind(cv); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if cv.Size != models.NetworkingCreateVpcSizeMEDIUM || cv.Type != models.NetworkingCreateVpcTypeFLAT {
+			t.Errorf("unexpected size or type: %s %s", cv.Size, cv.Type)
+		}
+
+		if cv.GatewayEndpoints == nil || len(*cv.GatewayEndpoints) != 1 {
+			t.Errorf("expected one gateway endpoint, got: %v", cv.GatewayEndpoints)
+		}
+
+		created := &models.NetworkingCreateVpcEvent{DetailType: "stax.networking.vpc.create"}
+		created.Detail.Message = &message
+		created.Detail.OperationStatus = staxsdk.TaskStarted
+		created.Detail.VPC.Id = aws.String(vpcID)
+
+		return c.JSON(200, created)
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("NetworkingReadVpc", mock.AnythingOfType("*echo.context"), vpcID, mock.AnythingOfType("models.NetworkingReadVpcParams")).Return(func(c echo.Context, vpcID string, params models.NetworkingReadVpcParams) error {
+		status := models.VPCStatusACTIVE
+		size := models.VPCSizeMEDIUM
+		vpcType := models.VPCTypeFLAT
+
+		return c.JSON(200, &models.NetworkingReadVpcs{
+			Vpcs: []models.VPC{
+				{
+					Id:               aws.String(vpcID),
+					NetworkingHubId:  aws.String(hubID),
+					Name:             "workloads",
+					AccountId:        accountID,
+					Region:           "ap-southeast-2",
+					CidrRangeId:      aws.String(cidrRangeID),
+					Cidr:             aws.String("10.1.0.0/22"),
+					Size:             &size,
+					Type:             &vpcType,
+					Zone:             aws.String("production"),
+					CreateIgw:        aws.Bool(false),
+					CreateVgw:        aws.Bool(false),
+					CreateFlowlogCwl: aws.Bool(true),
+					GatewayEndpoints: &[]models.GatewayEndpoint{models.S3},
+					PhzPrefix:        aws.String("workloads"),
+					AwsVpcId:         aws.String("vpc-0a1b2c3d4e5f6a7b8"),
+					Status:           &status,
+				},
+			},
+		})
+	})
+
+	si.On("NetworkingDeleteVpc", mock.AnythingOfType("*echo.context"), vpcID).Return(func(c echo.Context, vpcID string) error {
+		return c.JSON(200, map[string]interface{}{
+			"DetailType": "stax.networking.vpc.delete",
+			"Detail":     &models.BaseEventDetail{Message: &message, OperationStatus: staxsdk.TaskStarted},
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingVpcConfig("workloads", hubID, cidrRangeID, accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_vpc.workloads", "id", vpcID),
+					resource.TestCheckResourceAttr("stax_networking_vpc.workloads", "cidr", "10.1.0.0/22"),
+					resource.TestCheckResourceAttr("stax_networking_vpc.workloads", "aws_vpc_id", "vpc-0a1b2c3d4e5f6a7b8"),
+					resource.TestCheckResourceAttr("stax_networking_vpc.workloads", "create_internet_gateway", "false"),
+					resource.TestCheckResourceAttr("stax_networking_vpc.workloads", "phz_prefix", "workloads"),
+					resource.TestCheckResourceAttr("stax_networking_vpc.workloads", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingVpcConfig(label, hubID, cidrRangeID, accountID string) string {
+	configTemplate := `
+resource "stax_networking_vpc" "${label}" {
+	networking_hub_id = "${networking_hub_id}"
+	name              = "workloads"
+	account_id        = "${account_id}"
+	region            = "ap-southeast-2"
+	cidr_range_id     = "${cidr_range_id}"
+	size              = "MEDIUM"
+	type              = "FLAT"
+	zone              = "production"
+	create_flow_logs  = true
+	gateway_endpoints = ["s3"]
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":             label,
+			"networking_hub_id": hubID,
+			"cidr_range_id":     cidrRangeID,
+			"account_id":        accountID,
+		},
+	)
+}
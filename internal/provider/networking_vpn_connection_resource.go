@@ -0,0 +1,536 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/client"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// defaultTunnelsUpTimeout is how long to wait for the tunnels of a VPN connection to report up when tunnels_up_timeout
+// isn't configured.
+const defaultTunnelsUpTimeout = 30 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingVpnConnectionResource{}
+var _ resource.ResourceWithConfigure = &NetworkingVpnConnectionResource{}
+var _ resource.ResourceWithImportState = &NetworkingVpnConnectionResource{}
+
+type NetworkingVpnConnectionResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	VpnCustomerGatewayID types.String   `tfsdk:"vpn_customer_gateway_id"`
+	Name                 types.String   `tfsdk:"name"`
+	NetworkingHubID      types.String   `tfsdk:"networking_hub_id"`
+	VpcID                types.String   `tfsdk:"vpc_id"`
+	ImprovedAcceleration types.Bool     `tfsdk:"improved_acceleration"`
+	WaitForTunnelsUp     types.Bool     `tfsdk:"wait_for_tunnels_up"`
+	TunnelsUpTimeout     types.String   `tfsdk:"tunnels_up_timeout"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	ConnectionType       types.String   `tfsdk:"connection_type"`
+	AwsVpnConnectionID   types.String   `tfsdk:"aws_vpn_connection_id"`
+	Tunnels              types.List     `tfsdk:"tunnels"`
+	Status               types.String   `tfsdk:"status"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NetworkingVpnTunnelModel describes the status of one of the tunnels of a VPN connection.
+type NetworkingVpnTunnelModel struct {
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+var networkingVpnTunnelAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"status": types.StringType,
+}
+
+func NewNetworkingVpnConnectionResource() resource.Resource {
+	return &NetworkingVpnConnectionResource{}
+}
+
+// NetworkingVpnConnectionResource defines the resource implementation.
+type NetworkingVpnConnectionResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingVpnConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_vpn_connection"
+}
+
+func (r *NetworkingVpnConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking VPN connection resource. A site to site VPN connection between a VPN customer gateway and either a networking hub or a VPC. The status of the VPN tunnels is read with the connection, and the create can optionally wait until all the tunnels report `up`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "VPN connection identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpn_customer_gateway_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the VPN customer gateway the VPN connection is created for, changing this replaces the VPN connection",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the VPN connection",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"networking_hub_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the networking hub to connect, changing this replaces the VPN connection. Must provide only one of `networking_hub_id` or `vpc_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("vpc_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the Stax VPC to connect, changing this replaces the VPN connection. Must provide only one of `networking_hub_id` or `vpc_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"improved_acceleration": schema.BoolAttribute{
+				MarkdownDescription: "Use AWS Global Accelerator and the AWS global network for improved performance, this is only supported for networking hub connections, changing this replaces the VPN connection",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("vpc_id")),
+				},
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_tunnels_up": schema.BoolAttribute{
+				MarkdownDescription: "Wait until all the tunnels of the VPN connection report `up` when it is created, this requires the customer gateway device to be configured. If the tunnels don't come up within `tunnels_up_timeout` the create fails and the VPN connection is marked as tainted. Defaults to `false`.",
+				Optional:            true,
+			},
+			"tunnels_up_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the tunnels to report `up` when `wait_for_tunnels_up` is set, for example `30m` or `2h`, the wait also ends if the create timeout elapses first. Defaults to `30m`.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationAtLeast(time.Second),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the VPN connection",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"connection_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the VPN connection, either `HUB` or `VPC`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_vpn_connection_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS identifier of the VPN connection",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tunnels": schema.ListNestedAttribute{
+				MarkdownDescription: "The status of the tunnels of the VPN connection",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the tunnel",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the tunnel, either `up` or `down`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the VPN connection",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *NetworkingVpnConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingVpnConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingVpnConnectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the connection is read with the request context once created, so it is saved even if the create timeout elapsed
+	readCtx := ctx
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	tunnelsUpTimeout := defaultTunnelsUpTimeout
+	if !data.TunnelsUpTimeout.IsNull() && !data.TunnelsUpTimeout.IsUnknown() {
+		tunnelsUpTimeout = parseDuration(data.TunnelsUpTimeout, path.Root("tunnels_up_timeout"), &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createVpnConnection := models.NetworkingCreateVpnConnection{}
+
+	var err error
+
+	// the connection is created for either a networking hub or a VPC, the schema ensures exactly one is configured
+	if !data.NetworkingHubID.IsNull() {
+		err = createVpnConnection.FromHubConnection(models.HubConnection{
+			Name:                 data.Name.ValueString(),
+			NetworkingHubId:      data.NetworkingHubID.ValueString(),
+			ImprovedAcceleration: data.ImprovedAcceleration.ValueBool(),
+			Tags:                 (*models.NetworkingTags)(&staxTags),
+		})
+	} else {
+		err = createVpnConnection.FromVpcConnection(models.VpcConnection{
+			Name:  data.Name.ValueString(),
+			VpcId: data.VpcID.ValueString(),
+			Tags:  (*models.NetworkingTags)(&staxTags),
+		})
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to build networking vpn connection request, got error: %s", err))
+		return
+	}
+
+	created, err := r.client.NetworkingVpnConnectionCreate(ctx, data.VpnCustomerGatewayID.ValueString(), createVpnConnection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking vpn connection, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking vpn connection create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	if created.JSON200 == nil || created.JSON200.Detail.VpnConnection.Id == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking vpn connection, nil vpn connection id in response")
+		return
+	}
+
+	connectionID := *created.JSON200.Detail.VpnConnection.Id
+
+	// save the id before waiting, so the vpn connection is still tracked in state if the task fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), connectionID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tunnelsErr error
+
+	if data.WaitForTunnelsUp.ValueBool() {
+		_, tunnelsErr = r.client.MonitorVpnConnectionTunnels(ctx, connectionID, tunnelsUpTimeout, func(ctx context.Context, statusResp *client.NetworkingReadVpnConnectionStatusResp) bool {
+			tflog.Debug(ctx, "read status of vpn tunnels", map[string]interface{}{
+				"id":     connectionID,
+				"status": statusResp.JSON200,
+			})
+
+			return true
+		})
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingVpnConnection(readCtx, connectionID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(readCtx, &data)...)
+
+	// the connection exists so it is saved before reporting the tunnel error, which marks it as tainted
+	if tunnelsErr != nil {
+		addTunnelsUpError(&resp.Diagnostics, connectionID, tunnelsUpTimeout, timeout, tunnelsErr)
+	}
+}
+
+func (r *NetworkingVpnConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingVpnConnectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionResp, err := r.client.NetworkingVpnConnectionReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking vpn connection, got error: %s", err))
+		return
+	}
+
+	connection := connectionResp.JSON200.VpnConnections[0]
+
+	// deleted VPN connections are still returned by the API
+	if connection.Status != nil && *connection.Status == models.VpnConnectionStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking vpn connection", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	networkingVpnConnectionAPIToTFResource(connection, data)
+
+	resp.Diagnostics.Append(r.readNetworkingVpnTunnels(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpnConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingVpnConnectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update networking vpn connection", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updated, err := r.client.NetworkingVpnConnectionUpdate(ctx, data.ID.ValueString(), models.NetworkingUpdateVpnConnection{
+		Name: aws.String(data.Name.ValueString()),
+		Tags: (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking vpn connection, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, updated.Body, r.client, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingVpnConnection(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpnConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingVpnConnectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking vpn connection", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.NetworkingVpnConnectionDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking vpn connection, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func (r *NetworkingVpnConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingVpnConnectionResource) readNetworkingVpnConnection(ctx context.Context, connectionID string, data *NetworkingVpnConnectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	connectionResp, err := r.client.NetworkingVpnConnectionReadByID(ctx, connectionID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking vpn connection, got error: %s", err))
+		return diags
+	}
+
+	networkingVpnConnectionAPIToTFResource(connectionResp.JSON200.VpnConnections[0], data)
+
+	diags.Append(r.readNetworkingVpnTunnels(ctx, data)...)
+
+	return diags
+}
+
+func (r *NetworkingVpnConnectionResource) readNetworkingVpnTunnels(ctx context.Context, data *NetworkingVpnConnectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statusResp, err := r.client.NetworkingVpnConnectionReadStatus(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking vpn connection status, got error: %s", err))
+		return diags
+	}
+
+	tunnels := make([]NetworkingVpnTunnelModel, 0)
+	if statusResp.JSON200 != nil && statusResp.JSON200.ConnectionStatus != nil {
+		for _, tunnel := range *statusResp.JSON200.ConnectionStatus {
+			tunnels = append(tunnels, NetworkingVpnTunnelModel{
+				Name:   types.StringPointerValue(tunnel.TunnelName),
+				Status: types.StringPointerValue((*string)(tunnel.TunnelStatus)),
+			})
+		}
+	}
+
+	var d diag.Diagnostics
+	data.Tunnels, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: networkingVpnTunnelAttrTypes}, tunnels)
+	diags.Append(d...)
+
+	return diags
+}
+
+func networkingVpnConnectionAPIToTFResource(connection models.VpnConnection, data *NetworkingVpnConnectionResourceModel) {
+	data.ID = types.StringPointerValue(connection.Id)
+	data.VpnCustomerGatewayID = types.StringValue(connection.VpnCustomerGatewayId)
+	data.Name = types.StringValue(connection.Name)
+	data.ImprovedAcceleration = types.BoolValue(aws.ToBool(connection.ImprovedAcceleration))
+	data.ConnectionType = types.StringPointerValue((*string)(connection.VpnConnectionType))
+	data.AwsVpnConnectionID = types.StringPointerValue(connection.AwsVpnConnectionId)
+	data.Status = types.StringPointerValue((*string)(connection.Status))
+
+	// VPC connections also reference the hub of the VPC, so only the identifier matching the type is kept
+	if connection.VpnConnectionType != nil && *connection.VpnConnectionType == models.VpnConnectionVpnConnectionTypeVPC {
+		data.VpcID = types.StringPointerValue(connection.VpcId)
+		data.NetworkingHubID = types.StringNull()
+	} else {
+		data.NetworkingHubID = types.StringPointerValue(connection.NetworkingHubId)
+		data.VpcID = types.StringNull()
+	}
+
+	if connection.Tags != nil && len(*connection.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(connection.Tags)))
+	}
+}
+
+// addTunnelsUpError adds an error diagnostic for a failed wait on the tunnels of a VPN connection, this explains how to
+// increase tunnels_up_timeout if the tunnels didn't come up in time. The wait is also bounded by the create timeout,
+// which is reported as a task timeout instead.
+func addTunnelsUpError(diags *diag.Diagnostics, connectionID string, tunnelsUpTimeout, createTimeout time.Duration, err error) {
+	if errors.Is(err, helpers.ErrTaskTimeout) {
+		diags.AddError(
+			"Timeout Error",
+			fmt.Sprintf("The tunnels of the VPN connection %s did not report up within %s, got error: %s. Check the configuration of the "+
+				"customer gateway device, or increase tunnels_up_timeout if the tunnels take longer to come up.", connectionID, tunnelsUpTimeout, err),
+		)
+
+		return
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		addTaskError(diags, fmt.Sprintf("Unable to wait for the tunnels of the VPN connection %s", connectionID), timeoutCreate, createTimeout, err)
+		return
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("Unable to read the tunnel status of the VPN connection %s, got error: %s", connectionID, err))
+}
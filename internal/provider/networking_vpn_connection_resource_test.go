@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingVpnConnectionResource(t *testing.T) {
+
+	customerGatewayID := "9a0b1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d"
+	hubID := "7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	connectionID := "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	message := models.MessageEventDetail{}
+	if err := message.FromMessageEventDetail0(models.MessageEventDetail0{TaskId: aws.String(taskID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	si.On("NetworkingCreateVpnConnection", mock.AnythingOfType("*echo.context"), customerGatewayID).Return(func(c echo.Context, customerGatewayID string) error {
+		cr := new(models.NetworkingCreateVpnConnection)
+		if err := c.Bind(cr); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		hubConnection, err := cr.AsHubConnection()
+		if err != nil {
+			t.Fatalf("failed to read hub connection: %s", err)
+		}
+
+		if hubConnection.NetworkingHubId != hubID {
+			t.Errorf("unexpected networking hub id: %s", hubConnection.NetworkingHubId)
+		}
+
+		created := &models.NetworkingCreateVpnConnectionEvent{DetailType: "stax.networking.vpn_connection.create"}
+		created.Detail.Message = &message
+		created.Detail.OperationStatus = staxsdk.TaskStarted
+		created.Detail.VpnConnection.Id = aws.String(connectionID)
+
+		return c.JSON(200, created)
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("NetworkingReadVpnConnection", mock.AnythingOfType("*echo.context"), connectionID, mock.AnythingOfType("models.NetworkingReadVpnConnectionParams")).Return(func(c echo.Context, connectionID string, params models.NetworkingReadVpnConnectionParams) error {
+		status := models.VpnConnectionStatusACTIVE
+		connectionType := models.VpnConnectionVpnConnectionTypeHUB
+
+		return c.JSON(200, &models.NetworkingReadVpnConnections{
+			VpnConnections: []models.VpnConnection{
+				{
+					Id:                   aws.String(connectionID),
+					Name:                 "office",
+					VpnCustomerGatewayId: customerGatewayID,
+					NetworkingHubId:      aws.String(hubID),
+					ImprovedAcceleration: aws.Bool(false),
+					VpnConnectionType:    &connectionType,
+					AwsVpnConnectionId:   aws.String("vpn-0123456789abcdef0"),
+					Status:               &status,
+				},
+			},
+		})
+	})
+
+	si.On("NetworkingReadVpnConnectionStatus", mock.AnythingOfType("*echo.context"), connectionID).Return(func(c echo.Context, connectionID string) error {
+		up := models.Up
+
+		return c.JSON(200, &models.NetworkingReadVpnConnectionStatus{
+			ConnectionStatus: &models.ConnectionStatus{
+				{TunnelName: aws.String("Tunnel1"), TunnelStatus: &up},
+				{TunnelName: aws.String("Tunnel2"), TunnelStatus: &up},
+			},
+		})
+	})
+
+	si.On("NetworkingDeleteVpnConnection", mock.AnythingOfType("*echo.context"), connectionID).Return(func(c echo.Context, connectionID string) error {
+		deleted := &models.NetworkingDeleteVpnConnectionEvent{DetailType: "stax.networking.vpn_connection.delete"}
+		deleted.Detail.Message = &message
+		deleted.Detail.OperationStatus = staxsdk.TaskStarted
+
+		return c.JSON(200, deleted)
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingVpnConnectionConfig("office", customerGatewayID, hubID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_vpn_connection.office", "id", connectionID),
+					resource.TestCheckResourceAttr("stax_networking_vpn_connection.office", "connection_type", "HUB"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_connection.office", "aws_vpn_connection_id", "vpn-0123456789abcdef0"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_connection.office", "tunnels.#", "2"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_connection.office", "tunnels.0.name", "Tunnel1"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_connection.office", "tunnels.1.status", "up"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_connection.office", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingVpnConnectionConfig(label, customerGatewayID, hubID string) string {
+	configTemplate := `
+resource "stax_networking_vpn_connection" "${label}" {
+	vpn_customer_gateway_id = "${vpn_customer_gateway_id}"
+	networking_hub_id       = "${networking_hub_id}"
+	name                    = "office"
+	wait_for_tunnels_up     = true
+	tunnels_up_timeout      = "5m"
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":                   label,
+			"vpn_customer_gateway_id": customerGatewayID,
+			"networking_hub_id":       hubID,
+		},
+	)
+}
+
+func TestAddTunnelsUpError(t *testing.T) {
+	connectionID := "3f2a9c1e-7b4d-4e8a-9c2b-1d5e6f7a8b9c"
+
+	t.Run("reports the tunnels_up_timeout when the tunnels don't come up", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addTunnelsUpError(&diags, connectionID, 10*time.Minute, 30*time.Minute, fmt.Errorf("vpn tunnels not up: %w", &helpers.TaskTimeoutError{Timeout: 10 * time.Minute}))
+
+		require.Equal(t, "Timeout Error", diags[0].Summary())
+		require.Contains(t, diags[0].Detail(), "increase tunnels_up_timeout")
+	})
+
+	t.Run("reports the create timeout when it elapses first", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addTunnelsUpError(&diags, connectionID, 60*time.Minute, 30*time.Minute, fmt.Errorf("vpn tunnels not up: %w", context.DeadlineExceeded))
+
+		require.Equal(t, "Timeout Error", diags[0].Summary())
+		require.Contains(t, diags[0].Detail(), "the create timeout of 30m0s elapsed")
+		require.NotContains(t, diags[0].Detail(), "tunnels_up_timeout")
+	})
+
+	t.Run("reports other errors as client errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addTunnelsUpError(&diags, connectionID, 10*time.Minute, 30*time.Minute, fmt.Errorf("request failed, returned non 200 status: 500"))
+
+		require.Equal(t, "Client Error", diags[0].Summary())
+	})
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkingVpnCustomerGatewayResource{}
+var _ resource.ResourceWithConfigure = &NetworkingVpnCustomerGatewayResource{}
+var _ resource.ResourceWithImportState = &NetworkingVpnCustomerGatewayResource{}
+
+type NetworkingVpnCustomerGatewayResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	AccountID            types.String   `tfsdk:"account_id"`
+	Region               types.String   `tfsdk:"region"`
+	Asn                  types.Int64    `tfsdk:"asn"`
+	IPAddress            types.String   `tfsdk:"ip_address"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	AwsCustomerGatewayID types.String   `tfsdk:"aws_customer_gateway_id"`
+	Status               types.String   `tfsdk:"status"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewNetworkingVpnCustomerGatewayResource() resource.Resource {
+	return &NetworkingVpnCustomerGatewayResource{}
+}
+
+// NetworkingVpnCustomerGatewayResource defines the resource implementation.
+type NetworkingVpnCustomerGatewayResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_vpn_customer_gateway"
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Networking VPN customer gateway resource. A customer gateway describes the device on the customer side of a site to site VPN, VPN connections are created between the customer gateway and a networking hub or VPC.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "VPN customer gateway identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the VPN customer gateway",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the stax account the VPN customer gateway is created in, changing this replaces the VPN customer gateway",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region the VPN customer gateway is created in, changing this replaces the VPN customer gateway",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"asn": schema.Int64Attribute{
+				MarkdownDescription: "The BGP ASN of the customer gateway device, changing this replaces the VPN customer gateway",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967294),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "The static, internet routable IPv4 address of the customer gateway device outside interface, changing this replaces the VPN customer gateway",
+				Required:            true,
+				Validators: []validator.String{
+					ipv4Address(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the VPN customer gateway",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"aws_customer_gateway_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS identifier of the customer gateway",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the VPN customer gateway",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NetworkingVpnCustomerGatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.NetworkingVpnCustomerGatewayCreate(ctx, models.NetworkingCreateVpnCustomerGateway{
+		Name:      data.Name.ValueString(),
+		AccountId: data.AccountID.ValueString(),
+		Region:    models.AwsRegion(data.Region.ValueString()),
+		Asn:       int(data.Asn.ValueInt64()),
+		IpAddress: data.IPAddress.ValueString(),
+		Tags:      (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create networking vpn customer gateway, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "networking vpn customer gateway create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	if created.JSON200 == nil || created.JSON200.Detail.VpnCustomerGateway.Id == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create networking vpn customer gateway, nil vpn customer gateway id in response")
+		return
+	}
+
+	customerGatewayID := *created.JSON200.Detail.VpnCustomerGateway.Id
+
+	// save the id before waiting, so the customer gateway is still tracked in state if the task fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), customerGatewayID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, created.Body, r.client, timeoutCreate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingVpnCustomerGateway(ctx, customerGatewayID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NetworkingVpnCustomerGatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customerGatewayResp, err := r.client.NetworkingVpnCustomerGatewayReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networking vpn customer gateway, got error: %s", err))
+		return
+	}
+
+	customerGateway := customerGatewayResp.JSON200.VpnCustomerGateways[0]
+
+	// deleted VPN customer gateways are still returned by the API
+	if customerGateway.Status != nil && *customerGateway.Status == models.VpnCustomerGatewayStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "networking vpn customer gateway", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	networkingVpnCustomerGatewayAPIToTFResource(customerGateway, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NetworkingVpnCustomerGatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "update networking vpn customer gateway", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updated, err := r.client.NetworkingVpnCustomerGatewayUpdate(ctx, data.ID.ValueString(), models.NetworkingUpdateVpnCustomerGateway{
+		Name: aws.String(data.Name.ValueString()),
+		Tags: (*models.NetworkingTags)(&staxTags),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update networking vpn customer gateway, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, updated.Body, r.client, timeoutUpdate, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readNetworkingVpnCustomerGateway(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NetworkingVpnCustomerGatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete networking vpn customer gateway", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.NetworkingVpnCustomerGatewayDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete networking vpn customer gateway, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(waitForEventTask(ctx, deleted.Body, r.client, timeoutDelete, timeout)...)
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkingVpnCustomerGatewayResource) readNetworkingVpnCustomerGateway(ctx context.Context, customerGatewayID string, data *NetworkingVpnCustomerGatewayResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	customerGatewayResp, err := r.client.NetworkingVpnCustomerGatewayReadByID(ctx, customerGatewayID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networking vpn customer gateway, got error: %s", err))
+		return diags
+	}
+
+	networkingVpnCustomerGatewayAPIToTFResource(customerGatewayResp.JSON200.VpnCustomerGateways[0], data)
+
+	return diags
+}
+
+func networkingVpnCustomerGatewayAPIToTFResource(customerGateway models.VpnCustomerGateway, data *NetworkingVpnCustomerGatewayResourceModel) {
+	data.ID = types.StringPointerValue(customerGateway.Id)
+	data.Name = types.StringValue(customerGateway.Name)
+	data.AccountID = types.StringValue(customerGateway.AccountId)
+	data.Region = types.StringPointerValue((*string)(customerGateway.Region))
+	data.Asn = types.Int64Value(int64(customerGateway.Asn))
+	data.IPAddress = types.StringPointerValue(customerGateway.IpAddress)
+	data.AwsCustomerGatewayID = types.StringPointerValue(customerGateway.AwsVpnCustomerGatewayId)
+	data.Status = types.StringPointerValue((*string)(customerGateway.Status))
+
+	if customerGateway.Tags != nil && len(*customerGateway.Tags) > 0 {
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(customerGateway.Tags)))
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestNetworkingVpnCustomerGatewayResource(t *testing.T) {
+
+	customerGatewayID := "9a0b1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d"
+	accountID := "f646e0cf-840c-401a-933c-1ef3432b5a37"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	message := models.MessageEventDetail{}
+	if err := message.FromMessageEventDetail0(models.MessageEventDetail0{TaskId: aws.String(taskID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	si.On("NetworkingCreateVpnCustomerGateway", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		cr := new(models.NetworkingCreateVpnCustomerGateway)
+		if err := c.Bind(cr); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if cr.IpAddress != "203.0.113.10" {
+			t.Errorf("unexpected ip address: %s", cr.IpAddress)
+		}
+
+		created := &models.NetworkingCreateVpnCustomerGatewayEvent{DetailType: "stax.networking.vpn_customer_gateway.create"}
+		created.Detail.Message = &message
+		created.Detail.OperationStatus = staxsdk.TaskStarted
+		created.Detail.VpnCustomerGateway.Id = aws.String(customerGatewayID)
+
+		return c.JSON(200, created)
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded})
+	})
+
+	si.On("NetworkingReadVpnCustomerGateway", mock.AnythingOfType("*echo.context"), customerGatewayID, mock.AnythingOfType("models.NetworkingReadVpnCustomerGatewayParams")).Return(func(c echo.Context, customerGatewayID string, params models.NetworkingReadVpnCustomerGatewayParams) error {
+		status := models.VpnCustomerGatewayStatusACTIVE
+		region := models.AwsRegion("ap-southeast-2")
+
+		return c.JSON(200, &models.NetworkingReadVpnCustomerGateways{
+			VpnCustomerGateways: []models.VpnCustomerGateway{
+				{
+					Id:                      aws.String(customerGatewayID),
+					Name:                    "office",
+					AccountId:               accountID,
+					Region:                  &region,
+					Asn:                     65000,
+					IpAddress:               aws.String("203.0.113.10"),
+					AwsVpnCustomerGatewayId: aws.String("cgw-0123456789abcdef0"),
+					Status:                  &status,
+					Tags:                    &models.NetworkingTags{"CostCode": "12345"},
+				},
+			},
+		})
+	})
+
+	si.On("NetworkingDeleteVpnCustomerGateway", mock.AnythingOfType("*echo.context"), customerGatewayID).Return(func(c echo.Context, customerGatewayID string) error {
+		deleted := &models.NetworkingDeleteVpnCustomerGatewayEvent{DetailType: "stax.networking.vpn_customer_gateway.delete"}
+		deleted.Detail.Message = &message
+		deleted.Detail.OperationStatus = staxsdk.TaskStarted
+
+		return c.JSON(200, deleted)
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxNetworkingVpnCustomerGatewayConfig("office", accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_networking_vpn_customer_gateway.office", "id", customerGatewayID),
+					resource.TestCheckResourceAttr("stax_networking_vpn_customer_gateway.office", "asn", "65000"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_customer_gateway.office", "aws_customer_gateway_id", "cgw-0123456789abcdef0"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_customer_gateway.office", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("stax_networking_vpn_customer_gateway.office", "tags.CostCode", "12345"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxNetworkingVpnCustomerGatewayConfig(label, accountID string) string {
+	configTemplate := `
+resource "stax_networking_vpn_customer_gateway" "${label}" {
+	name       = "office"
+	account_id = "${account_id}"
+	region     = "ap-southeast-2"
+	asn        = 65000
+	ip_address = "203.0.113.10"
+	tags = {
+		CostCode = "12345"
+	}
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":      label,
+			"account_id": accountID,
+		},
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,7 +18,6 @@ import (
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/permissionssets/client"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/permissionssets/models"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
-	"golang.org/x/exp/slices"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -26,13 +26,14 @@ var _ resource.ResourceWithConfigure = &PermissionSetAssignmentResource{}
 var _ resource.ResourceWithImportState = &PermissionSetAssignmentResource{}
 
 type PermissionSetAssignmentResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	PermissionSetID types.String `tfsdk:"permission_set_id"`
-	AccountTypeID   types.String `tfsdk:"account_type_id"`
-	GroupID         types.String `tfsdk:"group_id"`
-	Status          types.String `tfsdk:"status"`
-	CreatedBy       types.String `tfsdk:"created_by"`
-	CreatedTS       types.String `tfsdk:"created_ts"`
+	ID              types.String   `tfsdk:"id"`
+	PermissionSetID types.String   `tfsdk:"permission_set_id"`
+	AccountTypeID   types.String   `tfsdk:"account_type_id"`
+	GroupID         types.String   `tfsdk:"group_id"`
+	Status          types.String   `tfsdk:"status"`
+	CreatedBy       types.String   `tfsdk:"created_by"`
+	CreatedTS       types.String   `tfsdk:"created_ts"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewPermissionSetAssignmentResource() resource.Resource {
@@ -88,6 +89,9 @@ func (r *PermissionSetAssignmentResource) Schema(ctx context.Context, req resour
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
 	}
 }
 
@@ -121,6 +125,13 @@ func (r *PermissionSetAssignmentResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	params := models.CreateAssignmentsRequest{}
 
 	params = append(params, struct {
@@ -152,7 +163,7 @@ func (r *PermissionSetAssignmentResource) Create(ctx context.Context, req resour
 		return true
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permission set assignment, got error: %s", err))
+		addTaskError(&resp.Diagnostics, "Unable to create permission set assignment", timeoutCreate, timeout, err)
 		return
 	}
 
@@ -200,13 +211,20 @@ func (r *PermissionSetAssignmentResource) Read(ctx context.Context, req resource
 	})
 
 	read, err := r.client.PermissionSetAssignmentList(ctx, data.PermissionSetID.ValueString(), &models.ListPermissionSetAssignmentsParams{})
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission set assignment, got error: %s", err))
 		return
 	}
 
-	if !slices.ContainsFunc(read.JSON200.Assignments, containsAssignmentRecord(data.ID.ValueString())) {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find assignment by permission set using id: %s", data.PermissionSetID.ValueString()))
+	// deleted assignments are either removed from the list or remain with a delete complete status
+	status, ok := getAssignmentStatus(data.ID.ValueString(), read.JSON200.Assignments)
+	if !ok || status == models.DELETECOMPLETE {
+		err = &staxsdk.NotFoundError{Resource: "permission set assignment", ID: data.ID.ValueString()}
+		removeNotFoundResource(ctx, err, data.ID.ValueString(), resp)
 		return
 	}
 
@@ -230,6 +248,13 @@ func (r *PermissionSetAssignmentResource) Delete(ctx context.Context, req resour
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.client.PermissionSetAssignmentDelete(ctx, data.PermissionSetID.ValueString(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission set assignment, got error: %s", err))
@@ -252,7 +277,7 @@ func (r *PermissionSetAssignmentResource) Delete(ctx context.Context, req resour
 		return true
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission set assignment, got error: %s", err))
+		addTaskError(&resp.Diagnostics, "Unable to delete permission set assignment", timeoutDelete, timeout, err)
 		return
 	}
 
@@ -301,7 +326,3 @@ func assignmentAPIToTFResource(assignment models.AssignmentRecord, data *Permiss
 	data.CreatedBy = types.StringValue(assignment.CreatedBy.String())
 	data.CreatedTS = types.StringValue(assignment.CreatedTS.Format(time.RFC3339))
 }
-
-func containsAssignmentRecord(id string) func(v models.AssignmentRecord) bool {
-	return func(v models.AssignmentRecord) bool { return v.Id.String() == id }
-}
@@ -208,6 +208,10 @@ func (r *PermissionSetResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	read, err := r.client.PermissionSetsReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission set, got error: %s", err))
 		return
@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"regexp"
+	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +21,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/cache"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/process"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/profile"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
 	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
 )
 
@@ -25,6 +32,13 @@ const (
 	installationEnvVar = "STAX_INSTALLATION"
 	accessKeyEnvVar    = "STAX_ACCESS_KEY"
 	secretKeyEnvVar    = "STAX_SECRET_KEY"
+
+	credentialCacheEnvVar = "STAX_CREDENTIAL_CACHE"
+
+	credentialProcessEnvVar = "STAX_CREDENTIAL_PROCESS"
+
+	profileEnvVar               = "STAX_PROFILE"
+	sharedCredentialsFileEnvVar = "STAX_SHARED_CREDENTIALS_FILE"
 )
 
 // Ensure StaxProvider satisfies various provider interfaces.
@@ -40,11 +54,29 @@ type StaxProvider struct {
 
 // StaxProviderModel describes the provider data model.
 type StaxProviderModel struct {
-	Installation              types.String `tfsdk:"installation"`
-	EndpointURL               types.String `tfsdk:"endpoint_url"`
-	PermissionSetsEndpointURL types.String `tfsdk:"permission_sets_endpoint_url"`
-	APITokenAccessKey         types.String `tfsdk:"api_token_access_key"`
-	APITokenSecretKey         types.String `tfsdk:"api_token_secret_key"`
+	Installation              types.String   `tfsdk:"installation"`
+	EndpointURL               types.String   `tfsdk:"endpoint_url"`
+	PermissionSetsEndpointURL types.String   `tfsdk:"permission_sets_endpoint_url"`
+	APITokenAccessKey         types.String   `tfsdk:"api_token_access_key"`
+	APITokenSecretKey         types.String   `tfsdk:"api_token_secret_key"`
+	CredentialCache           types.Bool     `tfsdk:"credential_cache"`
+	Profile                   types.String   `tfsdk:"profile"`
+	CredentialProcess         types.String   `tfsdk:"credential_process"`
+	Retry                     *RetryModel    `tfsdk:"retry"`
+	TaskPoll                  *TaskPollModel `tfsdk:"task_poll"`
+}
+
+// RetryModel describes the retry configuration for throttled and failed requests.
+type RetryModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff  types.String `tfsdk:"min_backoff"`
+	MaxBackoff  types.String `tfsdk:"max_backoff"`
+}
+
+// TaskPollModel describes the polling configuration for asynchronous tasks.
+type TaskPollModel struct {
+	Interval types.String `tfsdk:"interval"`
+	Timeout  types.String `tfsdk:"timeout"`
 }
 
 func (p *StaxProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -91,6 +123,71 @@ func (p *StaxProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				},
 				Sensitive: true,
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Name of the profile in the Stax shared credentials file, `~/.stax/credentials`, which provides the `installation`, and either the `api_token_access_key` and `api_token_secret_key` or a `credential_process`. Values set in the provider configuration or environment variables take precedence over the profile. Alternatively, can be configured using the `%s` environment variable, and the location of the file can be changed using the `%s` environment variable. Defaults to the `default` profile, if the file exists.", profileEnvVar, sharedCredentialsFileEnvVar),
+				Optional:            true,
+			},
+			"credential_process": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Command which prints the Stax API Token as JSON, for example `{\"Version\": 1, \"AccessKey\": \"...\", \"SecretKey\": \"...\", \"Expiration\": \"2023-08-01T12:00:00Z\"}`. The optional `Expiration` causes the command to be run again shortly before the API Token expires. Alternatively, can be configured using the `%s` environment variable or the `credential_process` key of a profile. Conflicts with `api_token_access_key` and `api_token_secret_key`.", credentialProcessEnvVar),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("api_token_access_key"),
+						path.MatchRoot("api_token_secret_key"),
+					),
+				},
+			},
+			"credential_cache": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Cache the authenticated session on disk so it is reused by other runs of the provider until shortly before it expires, this avoids authenticating on every plan and apply. Entries are encrypted using the API Token Secret Key and are only readable by the current user. Alternatively, can be enabled by setting the `%s` environment variable to `true`. Defaults to `false`.", credentialCacheEnvVar),
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures how throttled and failed requests to the Stax API are retried with exponential backoff.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of attempts made for each request, including the first. Set to `1` to disable retries. Defaults to `5`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						MarkdownDescription: "Delay before the first retry, this is doubled for each subsequent retry, for example `500ms`. Defaults to `500ms`.",
+						Optional:            true,
+						Validators: []validator.String{
+							durationAtLeast(time.Millisecond),
+						},
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "Maximum delay between retries, for example `20s`. Defaults to `20s`.",
+						Optional:            true,
+						Validators: []validator.String{
+							durationAtLeast(time.Millisecond),
+						},
+					},
+				},
+			},
+			"task_poll": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures how asynchronous Stax tasks, such as creating accounts, are polled until they complete.",
+				Attributes: map[string]schema.Attribute{
+					"interval": schema.StringAttribute{
+						MarkdownDescription: "Fixed interval between polls of the task status, for example `10s`. Defaults to an interval which starts at `5s` and backs off to `30s`.",
+						Optional:            true,
+						Validators: []validator.String{
+							durationAtLeast(time.Second),
+						},
+					},
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: "Maximum time to wait for a task to complete, for example `2h`. Set to `0s` to wait indefinitely. Defaults to `60m`.",
+						Optional:            true,
+						Validators: []validator.String{
+							durationAtLeast(0),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -119,20 +216,43 @@ func (p *StaxProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	installationOpt := resolveEndpointConfiguration(ctx, data, resp)
+	sharedProfile := resolveProfile(ctx, data, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiToken := resolveAPIToken(data, resp)
+	installationOpt := resolveEndpointConfiguration(ctx, data, sharedProfile, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	apiToken, apiTokenOpt := resolveAPIToken(ctx, data, sharedProfile, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialCacheOpt := resolveCredentialCache(ctx, data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retryOpt := resolveRetryConfig(data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskPollOpt := resolveTaskPollConfig(data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientOpts := append(append(installationOpt, apiTokenOpt...), credentialCacheOpt...)
+	clientOpts = append(append(clientOpts, retryOpt...), taskPollOpt...)
+
 	client, err := staxsdk.NewClient(
 		ctx,
 		apiToken,
-		append(installationOpt, staxsdk.WithUserAgentVersion(fmt.Sprintf("terraform-provider-stax/%s", p.version)))...,
+		append(clientOpts, staxsdk.WithUserAgentVersion(fmt.Sprintf("terraform-provider-stax/%s", p.version)))...,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
@@ -159,6 +279,20 @@ func (p *StaxProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewGroupMembershipResource,
 		NewPermissionSetResource,
 		NewPermissionSetAssignmentResource,
+		NewWorkloadResource,
+		NewCatalogueItemResource,
+		NewCatalogueVersionResource,
+		NewAccountTypePolicyAttachmentResource,
+		NewAccountTypeAccessResource,
+		NewAccountTypeMembersResource,
+		NewNetworkingHubResource,
+		NewNetworkingVpcResource,
+		NewNetworkingCidrRangeResource,
+		NewNetworkingCidrExclusionResource,
+		NewNetworkingDnsResolverResource,
+		NewNetworkingDnsRuleResource,
+		NewNetworkingVpnCustomerGatewayResource,
+		NewNetworkingVpnConnectionResource,
 	}
 }
 
@@ -171,10 +305,80 @@ func (p *StaxProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewAPITokensDataSource,
 		NewPermissionSetsDataSource,
 		NewPermissionSetAssignmentsDataSource,
+		NewCatalogueItemsDataSource,
+		NewCatalogueItemDataSource,
+		NewWorkloadsDataSource,
 	}
 }
 
-func resolveEndpointConfiguration(ctx context.Context, data StaxProviderModel, resp *provider.ConfigureResponse) []staxsdk.ClientOption {
+// resolveProfile loads the selected profile from the shared credentials file, returning nil if no profile was
+// selected and the file or default profile does not exist. When no profile was selected a default profile which
+// can't be loaded is reported as a warning, as the credentials may be provided by the configuration or environment.
+func resolveProfile(ctx context.Context, data StaxProviderModel, resp *provider.ConfigureResponse) *profile.Profile {
+	profileName := os.Getenv(profileEnvVar)
+
+	if !data.Profile.IsNull() {
+		profileName = data.Profile.ValueString()
+	}
+
+	selected := profileName != ""
+	if !selected {
+		profileName = profile.DefaultProfileName
+	}
+
+	filename := os.Getenv(sharedCredentialsFileEnvVar)
+	if filename == "" {
+		defaultFilename, err := profile.DefaultFilename()
+		if err != nil {
+			if selected {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("profile"),
+					"Unable to locate Stax Shared Credentials File",
+					fmt.Sprintf("The provider cannot locate the shared credentials file, got error: %s", err),
+				)
+			}
+
+			return nil
+		}
+
+		filename = defaultFilename
+	}
+
+	sharedProfile, err := profile.Load(filename, profileName)
+	if err != nil {
+		if !selected {
+			// the default profile is optional
+			if errors.Is(err, os.ErrNotExist) || errors.Is(err, profile.ErrProfileNotFound) {
+				return nil
+			}
+
+			// the default profile was not asked for, so the configuration or environment may provide the credentials
+			resp.Diagnostics.AddWarning(
+				"Unable to load Stax Profile",
+				fmt.Sprintf("The provider cannot load the %q profile from the shared credentials file and is ignoring it, got error: %s", profileName, err),
+			)
+
+			return nil
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unable to load Stax Profile",
+			fmt.Sprintf("The provider cannot load the %q profile from the shared credentials file, got error: %s", profileName, err),
+		)
+
+		return nil
+	}
+
+	tflog.Info(ctx, "using profile", map[string]interface{}{
+		"profile":  sharedProfile.Name,
+		"filename": filename,
+	})
+
+	return sharedProfile
+}
+
+func resolveEndpointConfiguration(ctx context.Context, data StaxProviderModel, sharedProfile *profile.Profile, resp *provider.ConfigureResponse) []staxsdk.ClientOption {
 
 	// if an endpoint is configured use it
 	if !data.EndpointURL.IsNull() && !data.PermissionSetsEndpointURL.IsNull() {
@@ -198,21 +402,81 @@ func resolveEndpointConfiguration(ctx context.Context, data StaxProviderModel, r
 		return []staxsdk.ClientOption{staxsdk.WithInstallation(installation)}
 	}
 
+	if sharedProfile != nil && sharedProfile.Installation != "" {
+		return []staxsdk.ClientOption{staxsdk.WithInstallation(sharedProfile.Installation)}
+	}
+
 	resp.Diagnostics.AddAttributeError(
 		path.Root("installation"),
 		"Unknown Stax Installation",
 		"The provider cannot create the Stax API client as there is an unknown configuration value for the Stax Installation. "+
-			fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, or use the %s environment variable.", installationEnvVar),
+			fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, use the %s environment variable, or select a profile from the shared credentials file.", installationEnvVar),
 	)
 
 	return nil
 }
 
-func resolveAPIToken(data StaxProviderModel, resp *provider.ConfigureResponse) *auth.APIToken {
+// resolveCredentialProcess returns the configured credential process command, or an empty string if there is none.
+func resolveCredentialProcess(data StaxProviderModel, sharedProfile *profile.Profile) string {
+	if !data.CredentialProcess.IsNull() {
+		return data.CredentialProcess.ValueString()
+	}
+
+	// api token keys set in the configuration take precedence over the environment and profile
+	if !data.APITokenAccessKey.IsNull() || !data.APITokenSecretKey.IsNull() {
+		return ""
+	}
+
+	if command := os.Getenv(credentialProcessEnvVar); command != "" {
+		return command
+	}
+
+	// api token keys set in the environment take precedence over the profile
+	if os.Getenv(accessKeyEnvVar) != "" || os.Getenv(secretKeyEnvVar) != "" {
+		return ""
+	}
+
+	if sharedProfile != nil {
+		return sharedProfile.CredentialProcess
+	}
+
+	return ""
+}
+
+func resolveAPIToken(ctx context.Context, data StaxProviderModel, sharedProfile *profile.Profile, resp *provider.ConfigureResponse) (*auth.APIToken, []staxsdk.ClientOption) {
+
+	if command := resolveCredentialProcess(data, sharedProfile); command != "" {
+		credentialProcess := process.New(command)
+
+		apiToken, err := credentialProcess.Retrieve(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credential_process"),
+				"Unable to run Stax Credential Process",
+				fmt.Sprintf("The provider cannot retrieve the Stax API Token from the credential process, got error: %s", err),
+			)
+
+			return nil, nil
+		}
+
+		tflog.Debug(ctx, "using credential process")
+
+		return apiToken, []staxsdk.ClientOption{staxsdk.WithAPITokenProvider(credentialProcess)}
+	}
+
+	apiToken := &auth.APIToken{}
 
-	apiToken := &auth.APIToken{
-		AccessKey: os.Getenv(accessKeyEnvVar),
-		SecretKey: os.Getenv(secretKeyEnvVar),
+	if sharedProfile != nil {
+		apiToken.AccessKey = sharedProfile.APITokenAccessKey
+		apiToken.SecretKey = sharedProfile.APITokenSecretKey
+	}
+
+	if accessKey := os.Getenv(accessKeyEnvVar); accessKey != "" {
+		apiToken.AccessKey = accessKey
+	}
+
+	if secretKey := os.Getenv(secretKeyEnvVar); secretKey != "" {
+		apiToken.SecretKey = secretKey
 	}
 
 	if !data.APITokenAccessKey.IsNull() {
@@ -228,7 +492,7 @@ func resolveAPIToken(data StaxProviderModel, resp *provider.ConfigureResponse) *
 			path.Root("api_token_access_key"),
 			"Unknown Stax API Token Access Key",
 			"The provider cannot create the Stax API client as there is an unknown configuration value for the Stax API Token Access Key. "+
-				fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, or use the %s environment variable.", accessKeyEnvVar),
+				fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, use the %s environment variable, or select a profile from the shared credentials file.", accessKeyEnvVar),
 		)
 	}
 
@@ -237,11 +501,130 @@ func resolveAPIToken(data StaxProviderModel, resp *provider.ConfigureResponse) *
 			path.Root("api_token_secret_key"),
 			"Unknown Stax API Token Secret Key",
 			"The provider cannot create the Stax API client as there is an unknown configuration value for the Stax API Token Access Key. "+
-				fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, or use the %s environment variable.", secretKeyEnvVar),
+				fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, use the %s environment variable, or select a profile from the shared credentials file.", secretKeyEnvVar),
 		)
 	}
 
-	return apiToken
+	return apiToken, nil
+}
+
+func resolveCredentialCache(ctx context.Context, data StaxProviderModel, resp *provider.ConfigureResponse) []staxsdk.ClientOption {
+	enabled := false
+
+	if value, ok := os.LookupEnv(credentialCacheEnvVar); ok {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Stax Credential Cache",
+				fmt.Sprintf("The %s environment variable must be either true or false, got: %s", credentialCacheEnvVar, value),
+			)
+
+			return nil
+		}
+
+		enabled = parsed
+	}
+
+	if !data.CredentialCache.IsNull() {
+		enabled = data.CredentialCache.ValueBool()
+	}
+
+	if !enabled {
+		return nil
+	}
+
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_cache"),
+			"Unable to locate Stax Credential Cache",
+			fmt.Sprintf("The provider cannot locate the user cache directory, got error: %s", err),
+		)
+
+		return nil
+	}
+
+	tflog.Debug(ctx, "using credential cache", map[string]interface{}{
+		"dir": dir,
+	})
+
+	return []staxsdk.ClientOption{staxsdk.WithCredentialCache(cache.NewFileCache(dir))}
+}
+
+func resolveRetryConfig(data StaxProviderModel, resp *provider.ConfigureResponse) []staxsdk.ClientOption {
+	if data.Retry == nil {
+		return nil
+	}
+
+	retryConfig := staxsdk.DefaultRetryConfig()
+
+	// values which are unknown at configure time, such as those from other resources, use the defaults
+	if !data.Retry.MaxAttempts.IsNull() && !data.Retry.MaxAttempts.IsUnknown() {
+		if data.Retry.MaxAttempts.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry").AtName("max_attempts"),
+				"Invalid Stax Retry Configuration",
+				fmt.Sprintf("The max_attempts must be at least 1, got: %d", data.Retry.MaxAttempts.ValueInt64()),
+			)
+
+			return nil
+		}
+
+		retryConfig.MaxAttempts = int(data.Retry.MaxAttempts.ValueInt64())
+	}
+
+	if !data.Retry.MinBackoff.IsNull() && !data.Retry.MinBackoff.IsUnknown() {
+		retryConfig.BaseDelay = parseDuration(data.Retry.MinBackoff, path.Root("retry").AtName("min_backoff"), &resp.Diagnostics)
+	}
+
+	if !data.Retry.MaxBackoff.IsNull() && !data.Retry.MaxBackoff.IsUnknown() {
+		retryConfig.MaxDelay = parseDuration(data.Retry.MaxBackoff, path.Root("retry").AtName("max_backoff"), &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	if retryConfig.MaxDelay < retryConfig.BaseDelay {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry").AtName("max_backoff"),
+			"Invalid Stax Retry Configuration",
+			fmt.Sprintf("The max_backoff must be greater than or equal to the min_backoff of %s, got: %s", retryConfig.BaseDelay, retryConfig.MaxDelay),
+		)
+
+		return nil
+	}
+
+	return []staxsdk.ClientOption{staxsdk.WithRetryConfig(retryConfig)}
+}
+
+func resolveTaskPollConfig(data StaxProviderModel, resp *provider.ConfigureResponse) []staxsdk.ClientOption {
+	if data.TaskPoll == nil {
+		return nil
+	}
+
+	pollerConfig := helpers.DefaultPollerConfig()
+
+	// values which are unknown at configure time, such as those from other resources, use the defaults
+	if !data.TaskPoll.Interval.IsNull() && !data.TaskPoll.Interval.IsUnknown() {
+		interval := parseDuration(data.TaskPoll.Interval, path.Root("task_poll").AtName("interval"), &resp.Diagnostics)
+
+		pollerConfig.Backoff = helpers.ExponentialBackoff{
+			InitialInterval: interval,
+			Multiplier:      1,
+			MaxInterval:     interval,
+		}
+	}
+
+	if !data.TaskPoll.Timeout.IsNull() && !data.TaskPoll.Timeout.IsUnknown() {
+		pollerConfig.Timeout = parseDuration(data.TaskPoll.Timeout, path.Root("task_poll").AtName("timeout"), &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	return []staxsdk.ClientOption{staxsdk.WithTaskPollerConfig(pollerConfig)}
 }
 
 func New(version string) func() provider.Provider {
@@ -252,6 +635,23 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// removeNotFoundResource removes the resource from the state if err is a not found error, so terraform plans to
+// recreate resources which were deleted outside of terraform. Returns true if the resource was removed.
+func removeNotFoundResource(ctx context.Context, err error, id string, resp *resource.ReadResponse) bool {
+	if !staxsdk.IsNotFound(err) {
+		return false
+	}
+
+	tflog.Warn(ctx, "resource not found, removing from state", map[string]interface{}{
+		"id":    id,
+		"error": err.Error(),
+	})
+
+	resp.State.RemoveResource(ctx)
+
+	return true
+}
+
 func timeToStringPtr(ts *time.Time) *string {
 	if ts == nil {
 		return nil
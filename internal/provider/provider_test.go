@@ -1,10 +1,19 @@
 package provider
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/auth/profile"
+	"github.com/stretchr/testify/require"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -21,3 +30,225 @@ func testAccPreCheck(t *testing.T) {
 	// function.
 
 }
+
+func TestResolveProfile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(filename, []byte(`[default]
+installation = au1
+api_token_access_key = b549185e-0fd7-44cf-a7b5-0751c720c0f0
+api_token_secret_key = secretau1
+
+[us1]
+installation = us1
+api_token_access_key = 6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e
+api_token_secret_key = secretus1
+`), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv(sharedCredentialsFileEnvVar, filename)
+	t.Setenv(accessKeyEnvVar, "")
+	t.Setenv(secretKeyEnvVar, "")
+
+	t.Run("uses the default profile", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Profile: types.StringNull(), APITokenAccessKey: types.StringNull(), APITokenSecretKey: types.StringNull()}
+
+		sharedProfile := resolveProfile(context.Background(), data, resp)
+		apiToken, _ := resolveAPIToken(context.Background(), data, sharedProfile, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, "au1", sharedProfile.Installation)
+		require.Equal(t, &auth.APIToken{AccessKey: "b549185e-0fd7-44cf-a7b5-0751c720c0f0", SecretKey: "secretau1"}, apiToken)
+	})
+
+	t.Run("selects a profile from the environment", func(t *testing.T) {
+		t.Setenv(profileEnvVar, "us1")
+
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Profile: types.StringNull(), APITokenAccessKey: types.StringNull(), APITokenSecretKey: types.StringNull()}
+
+		sharedProfile := resolveProfile(context.Background(), data, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, "us1", sharedProfile.Installation)
+	})
+
+	t.Run("configuration takes precedence over the profile", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Profile: types.StringValue("us1"), APITokenAccessKey: types.StringNull(), APITokenSecretKey: types.StringValue("override")}
+
+		sharedProfile := resolveProfile(context.Background(), data, resp)
+		apiToken, _ := resolveAPIToken(context.Background(), data, sharedProfile, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, &auth.APIToken{AccessKey: "6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e", SecretKey: "override"}, apiToken)
+	})
+
+	t.Run("reports an unknown profile", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Profile: types.StringValue("eu1")}
+
+		resolveProfile(context.Background(), data, resp)
+		require.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("ignores a missing default profile", func(t *testing.T) {
+		t.Setenv(sharedCredentialsFileEnvVar, filepath.Join(t.TempDir(), "missing"))
+
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Profile: types.StringNull()}
+
+		require.Nil(t, resolveProfile(context.Background(), data, resp))
+		require.False(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("warns about a malformed file when no profile was selected", func(t *testing.T) {
+		malformed := filepath.Join(t.TempDir(), "credentials")
+		require.NoError(t, os.WriteFile(malformed, []byte("installation = au1\n"), 0o600))
+
+		t.Setenv(sharedCredentialsFileEnvVar, malformed)
+
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Profile: types.StringNull()}
+
+		require.Nil(t, resolveProfile(context.Background(), data, resp))
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	})
+
+	t.Run("reports a malformed file when a profile was selected", func(t *testing.T) {
+		malformed := filepath.Join(t.TempDir(), "credentials")
+		require.NoError(t, os.WriteFile(malformed, []byte("installation = au1\n"), 0o600))
+
+		t.Setenv(sharedCredentialsFileEnvVar, malformed)
+
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Profile: types.StringValue("default")}
+
+		require.Nil(t, resolveProfile(context.Background(), data, resp))
+		require.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestResolveAPIToken_CredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a posix shell")
+	}
+
+	t.Setenv(credentialProcessEnvVar, `echo '{"AccessKey": "b549185e-0fd7-44cf-a7b5-0751c720c0f0", "SecretKey": "secret"}'`)
+
+	t.Run("runs the credential process", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{CredentialProcess: types.StringNull(), APITokenAccessKey: types.StringNull(), APITokenSecretKey: types.StringNull()}
+
+		apiToken, opts := resolveAPIToken(context.Background(), data, nil, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, &auth.APIToken{AccessKey: "b549185e-0fd7-44cf-a7b5-0751c720c0f0", SecretKey: "secret"}, apiToken)
+		require.Len(t, opts, 1)
+	})
+
+	t.Run("api token keys in the configuration take precedence over the environment", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{CredentialProcess: types.StringNull(), APITokenAccessKey: types.StringValue("6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e"), APITokenSecretKey: types.StringValue("static")}
+
+		apiToken, opts := resolveAPIToken(context.Background(), data, nil, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, "static", apiToken.SecretKey)
+		require.Empty(t, opts)
+	})
+
+	t.Run("api token keys in the environment take precedence over the profile", func(t *testing.T) {
+		t.Setenv(credentialProcessEnvVar, "")
+		t.Setenv(accessKeyEnvVar, "6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e")
+		t.Setenv(secretKeyEnvVar, "environment")
+
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{CredentialProcess: types.StringNull(), APITokenAccessKey: types.StringNull(), APITokenSecretKey: types.StringNull()}
+		sharedProfile := &profile.Profile{Name: "default", CredentialProcess: "exit 1"}
+
+		apiToken, opts := resolveAPIToken(context.Background(), data, sharedProfile, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, &auth.APIToken{AccessKey: "6ab4ab1f-4b3d-4b9b-9c39-3c6d4fb2ab8e", SecretKey: "environment"}, apiToken)
+		require.Empty(t, opts)
+	})
+
+	t.Run("reports a failing credential process", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{CredentialProcess: types.StringValue("exit 1"), APITokenAccessKey: types.StringNull(), APITokenSecretKey: types.StringNull()}
+
+		_, _ = resolveAPIToken(context.Background(), data, nil, resp)
+		require.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestResolveRetryConfig(t *testing.T) {
+	t.Run("uses the default configuration without a retry block", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+
+		opts := resolveRetryConfig(StaxProviderModel{}, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Empty(t, opts)
+	})
+
+	t.Run("configures retries", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Retry: &RetryModel{MaxAttempts: types.Int64Value(10), MinBackoff: types.StringValue("1s"), MaxBackoff: types.StringNull()}}
+
+		opts := resolveRetryConfig(data, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Len(t, opts, 1)
+	})
+
+	t.Run("uses the defaults for unknown values", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Retry: &RetryModel{MaxAttempts: types.Int64Unknown(), MinBackoff: types.StringUnknown(), MaxBackoff: types.StringUnknown()}}
+
+		opts := resolveRetryConfig(data, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Len(t, opts, 1)
+	})
+
+	t.Run("rejects a max_attempts less than 1", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Retry: &RetryModel{MaxAttempts: types.Int64Value(0), MinBackoff: types.StringNull(), MaxBackoff: types.StringNull()}}
+
+		opts := resolveRetryConfig(data, resp)
+		require.True(t, resp.Diagnostics.HasError())
+		require.Empty(t, opts)
+	})
+
+	t.Run("rejects a max_backoff less than the min_backoff", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{Retry: &RetryModel{MaxAttempts: types.Int64Null(), MinBackoff: types.StringValue("30s"), MaxBackoff: types.StringValue("10s")}}
+
+		opts := resolveRetryConfig(data, resp)
+		require.True(t, resp.Diagnostics.HasError())
+		require.Empty(t, opts)
+	})
+}
+
+func TestResolveTaskPollConfig(t *testing.T) {
+	t.Run("configures task polling", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{TaskPoll: &TaskPollModel{Interval: types.StringValue("10s"), Timeout: types.StringValue("2h")}}
+
+		opts := resolveTaskPollConfig(data, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Len(t, opts, 1)
+	})
+
+	t.Run("uses the defaults for unknown values", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{TaskPoll: &TaskPollModel{Interval: types.StringUnknown(), Timeout: types.StringUnknown()}}
+
+		opts := resolveTaskPollConfig(data, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		require.Len(t, opts, 1)
+	})
+
+	t.Run("rejects an invalid duration", func(t *testing.T) {
+		resp := &provider.ConfigureResponse{}
+		data := StaxProviderModel{TaskPoll: &TaskPollModel{Interval: types.StringNull(), Timeout: types.StringValue("2 hours")}}
+
+		opts := resolveTaskPollConfig(data, resp)
+		require.True(t, resp.Diagnostics.HasError())
+		require.Empty(t, opts)
+	})
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+const (
+	timeoutCreate = "create"
+	timeoutUpdate = "update"
+	timeoutDelete = "delete"
+)
+
+// timeoutsBlock returns the schema to use for the timeouts block, with an attribute for each of the operations in opts.
+// The timeouts default to the provider task_poll timeout, this is passed to withTaskTimeout.
+func timeoutsBlock(ctx context.Context, opts timeouts.Opts) schema.Block {
+	description := func(operation string) string {
+		return fmt.Sprintf("Maximum time to wait for the %s to complete, for example `30m` or `2h`. Defaults to the provider `task_poll` timeout.", operation)
+	}
+
+	if opts.Create && opts.CreateDescription == "" {
+		opts.CreateDescription = description(timeoutCreate)
+	}
+
+	if opts.Update && opts.UpdateDescription == "" {
+		opts.UpdateDescription = description(timeoutUpdate)
+	}
+
+	if opts.Delete && opts.DeleteDescription == "" {
+		opts.DeleteDescription = description(timeoutDelete)
+	}
+
+	block := timeouts.Block(ctx, opts)
+
+	if nestedBlock, ok := block.(schema.SingleNestedBlock); ok {
+		nestedBlock.MarkdownDescription = "Configures how long to wait for the asynchronous Stax tasks started by this resource to complete."
+
+		return nestedBlock
+	}
+
+	return block
+}
+
+// withTaskTimeout returns a context which is cancelled once the timeout for the operation elapses, along with the
+// timeout. timeoutFunc reads the timeout from the timeouts block, such as timeouts.Value.Create, and defaultTimeout is
+// used when it isn't configured. When the timeout is zero the context is returned without a deadline.
+func withTaskTimeout(ctx context.Context, timeoutFunc func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), defaultTimeout time.Duration, diags *diag.Diagnostics) (context.Context, context.CancelFunc, time.Duration) {
+	timeout, timeoutDiags := timeoutFunc(ctx, defaultTimeout)
+
+	diags.Append(timeoutDiags...)
+
+	if diags.HasError() || timeout <= 0 {
+		return ctx, func() {}, 0
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, cancel, timeout
+}
+
+// addTaskError adds an error diagnostic for a failed wait on an asynchronous task, this explains how to increase the
+// timeout if the operation timeout elapsed.
+func addTaskError(diags *diag.Diagnostics, summary string, operation string, timeout time.Duration, err error) {
+	if timeout > 0 && errors.Is(err, context.DeadlineExceeded) {
+		diags.AddError(
+			"Timeout Error",
+			fmt.Sprintf("%s, the %s timeout of %s elapsed before the Stax task completed. The task may still complete in Stax, "+
+				"increase the %s timeout in the timeouts block if it regularly takes longer.", summary, operation, timeout, operation),
+		)
+
+		return
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", summary, err))
+}
+
+// waitForEventTask waits for the task started by an asynchronous request to complete, the task is read from the event
+// response body.
+func waitForEventTask(ctx context.Context, body []byte, staxclient staxsdk.ClientInterface, operation string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	taskID, err := staxsdk.EventTaskID(body)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s task, got error: %s", operation, err))
+		return diags
+	}
+
+	_, err = waitForTask(ctx, taskID, staxclient)
+	if err != nil {
+		addTaskError(&diags, "Unable to complete task", operation, timeout, err)
+	}
+
+	return diags
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTaskTimeout(t *testing.T) {
+	t.Run("defaults to the provider task_poll timeout", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		ctx, cancel, timeout := withTaskTimeout(context.Background(), timeouts.Value{}.Create, 60*time.Minute, &diags)
+		defer cancel()
+
+		require.False(t, diags.HasError())
+		require.Equal(t, 60*time.Minute, timeout)
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(60*time.Minute), deadline, time.Minute)
+	})
+
+	t.Run("returns the context without a deadline when the timeout is zero", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		ctx, cancel, timeout := withTaskTimeout(context.Background(), timeouts.Value{}.Create, 0, &diags)
+		defer cancel()
+
+		require.False(t, diags.HasError())
+		require.Zero(t, timeout)
+
+		_, ok := ctx.Deadline()
+		require.False(t, ok)
+	})
+
+	t.Run("sets the deadline of the context", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		value := timeouts.Value{
+			Object: types.ObjectValueMust(
+				map[string]attr.Type{"create": types.StringType, "update": types.StringType, "delete": types.StringType},
+				map[string]attr.Value{"create": types.StringNull(), "update": types.StringValue("45m"), "delete": types.StringNull()},
+			),
+		}
+
+		ctx, cancel, timeout := withTaskTimeout(context.Background(), value.Update, 60*time.Minute, &diags)
+		defer cancel()
+
+		require.False(t, diags.HasError())
+		require.Equal(t, 45*time.Minute, timeout)
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(45*time.Minute), deadline, time.Minute)
+	})
+}
+
+func TestAddTaskError(t *testing.T) {
+	t.Run("reports an elapsed timeout", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addTaskError(&diags, "Unable to complete task", timeoutCreate, 30*time.Minute, fmt.Errorf("task failed: %w", context.DeadlineExceeded))
+
+		require.Equal(t, "Timeout Error", diags[0].Summary())
+		require.Contains(t, diags[0].Detail(), "the create timeout of 30m0s elapsed")
+	})
+
+	t.Run("reports other errors as client errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addTaskError(&diags, "Unable to complete task", timeoutCreate, 0, fmt.Errorf("task failed: %w", context.DeadlineExceeded))
+
+		require.Equal(t, "Client Error", diags[0].Summary())
+	})
+}
@@ -181,11 +181,30 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	resp.Diagnostics.Append(r.userRead(ctx, data.ID.ValueString(), data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	userRead, err := r.client.UserReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	// deleted users are still returned by the API
+	for _, user := range userRead.JSON200.Users {
+		if user.Status != nil && *user.Status == models.UserStatusDELETED {
+			removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "user", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+			return
+		}
+	}
+
+	userAPIToTFResource(userRead.JSON200.Users, data)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -296,7 +315,13 @@ func (r *UserResource) userRead(ctx context.Context, userID string, data *UserRe
 		return diags
 	}
 
-	for _, user := range userRead.JSON200.Users {
+	userAPIToTFResource(userRead.JSON200.Users, data)
+
+	return diags
+}
+
+func userAPIToTFResource(users []models.User, data *UserResourceModel) {
+	for _, user := range users {
 
 		var email *string
 		if user.Email != nil {
@@ -313,8 +338,6 @@ func (r *UserResource) userRead(ctx context.Context, userID string, data *UserRe
 		data.CreatedTS = types.StringPointerValue(timeToStringPtr(user.CreatedTS))
 		data.ModifiedTS = types.StringPointerValue(timeToStringPtr(user.ModifiedTS))
 	}
-
-	return diags
 }
 
 func extractUserID(message string) (string, error) {
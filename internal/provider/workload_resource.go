@@ -0,0 +1,537 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkloadResource{}
+var _ resource.ResourceWithConfigure = &WorkloadResource{}
+var _ resource.ResourceWithImportState = &WorkloadResource{}
+
+type WorkloadResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	AccountID          types.String   `tfsdk:"account_id"`
+	CatalogueID        types.String   `tfsdk:"catalogue_id"`
+	CatalogueVersionID types.String   `tfsdk:"catalogue_version_id"`
+	Region             types.String   `tfsdk:"region"`
+	Parameters         types.Map      `tfsdk:"parameters"`
+	Tags               types.Map      `tfsdk:"tags"`
+	Status             types.String   `tfsdk:"status"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewWorkloadResource() resource.Resource {
+	return &WorkloadResource{}
+}
+
+// WorkloadResource defines the resource implementation.
+type WorkloadResource struct {
+	client staxsdk.ClientInterface
+}
+
+func (r *WorkloadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workload"
+}
+
+func (r *WorkloadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workload resource. Stax Workloads deploy a version of a workload catalogue item into a Stax account and region.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Workload identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workload, changing this replaces the workload",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]*$`),
+						"must start with a letter and only contain letters, numbers and hyphens",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the stax account the workload is deployed to, changing this replaces the workload",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalogue_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the workload catalogue item to deploy",
+				Required:            true,
+			},
+			"catalogue_version_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the workload catalogue version to deploy, defaults to the latest version of the catalogue item",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("catalogue_id")),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region the workload is deployed to, changing this replaces the workload",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "The parameters passed to the workload catalogue template",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags associated with the workload",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the workload",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *WorkloadResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkloadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *WorkloadResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Create, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := workloadParametersTFToAPI(ctx, data.Parameters)
+	resp.Diagnostics.Append(diags...)
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createWorkload := models.WorkloadsCreateWorkload{
+		Name:        data.Name.ValueString(),
+		AccountId:   data.AccountID.ValueString(),
+		CatalogueId: data.CatalogueID.ValueString(),
+		Region:      models.AwsRegion(data.Region.ValueString()),
+		Parameters:  parameters,
+		Tags:        (*models.Tags)(&staxTags),
+	}
+
+	if !data.CatalogueVersionID.IsUnknown() && !data.CatalogueVersionID.IsNull() {
+		createWorkload.CatalogueVersionId = aws.String(data.CatalogueVersionID.ValueString())
+	}
+
+	created, err := r.client.WorkloadCreate(ctx, createWorkload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workload, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "workload create response", map[string]interface{}{
+		"JSON200": created.JSON200,
+	})
+
+	// save the id before waiting, so the workload is still tracked in state if the task fails
+	if created.JSON200 != nil && created.JSON200.WorkloadId != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *created.JSON200.WorkloadId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	taskID, err := staxsdk.EventTaskID(created.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workload create task, got error: %s", err))
+		return
+	}
+
+	taskResp, err := waitForTask(ctx, taskID, r.client)
+	if err != nil {
+		addTaskError(&resp.Diagnostics, "Unable to complete task", timeoutCreate, timeout, err)
+		return
+	}
+
+	tflog.Debug(ctx, "task response", map[string]interface{}{
+		"JSON200": taskResp,
+	})
+
+	var workloadID string
+
+	switch {
+	case taskResp.Workloads != nil && len(*taskResp.Workloads) > 0:
+		workloadID = (*taskResp.Workloads)[0]
+	case created.JSON200 != nil && created.JSON200.WorkloadId != nil:
+		workloadID = *created.JSON200.WorkloadId
+	default:
+		resp.Diagnostics.AddError("Client Error", "Unable to complete task, nil workload ids in task")
+		return
+	}
+
+	resp.Diagnostics.Append(r.readWorkload(ctx, workloadID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkloadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *WorkloadResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workloadResp, err := r.client.WorkloadReadByID(ctx, data.ID.ValueString())
+	if removeNotFoundResource(ctx, err, data.ID.ValueString(), resp) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workload, got error: %s", err))
+		return
+	}
+
+	workload := workloadResp.JSON200.Workloads[0]
+
+	// deleted workloads are still returned by the API
+	if workload.Status != nil && *workload.Status == models.WorkloadStatusDELETED {
+		removeNotFoundResource(ctx, &staxsdk.NotFoundError{Resource: "workload", ID: data.ID.ValueString()}, data.ID.ValueString(), resp)
+		return
+	}
+
+	resp.Diagnostics.Append(workloadAPIToTFResource(ctx, workload, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkloadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *WorkloadResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Update, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := workloadParametersTFToAPI(ctx, data.Parameters)
+	resp.Diagnostics.Append(diags...)
+
+	staxTags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &staxTags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateWorkload := models.WorkloadsUpdateWorkload{
+		CatalogueId: aws.String(data.CatalogueID.ValueString()),
+		Parameters:  parameters,
+		Tags:        (*models.Tags)(&staxTags),
+	}
+
+	if !data.CatalogueVersionID.IsUnknown() && !data.CatalogueVersionID.IsNull() {
+		updateWorkload.CatalogueVersionId = aws.String(data.CatalogueVersionID.ValueString())
+	}
+
+	tflog.Info(ctx, "update workload", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updated, err := r.client.WorkloadUpdate(ctx, data.ID.ValueString(), updateWorkload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workload, got error: %s", err))
+		return
+	}
+
+	taskID, err := staxsdk.EventTaskID(updated.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workload update task, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "wait for workload update", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err = waitForTask(ctx, taskID, r.client)
+	if err != nil {
+		addTaskError(&resp.Diagnostics, "Unable to complete task", timeoutUpdate, timeout, err)
+		return
+	}
+
+	resp.Diagnostics.Append(r.readWorkload(ctx, data.ID.ValueString(), data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkloadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *WorkloadResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, timeout := withTaskTimeout(ctx, data.Timeouts.Delete, r.client.TaskPollTimeout(), &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "delete workload", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deleted, err := r.client.WorkloadDelete(ctx, data.ID.ValueString())
+	if staxsdk.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workload, got error: %s", err))
+		return
+	}
+
+	taskID, err := staxsdk.EventTaskID(deleted.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workload delete task, got error: %s", err))
+		return
+	}
+
+	_, err = waitForTask(ctx, taskID, r.client)
+	if err != nil {
+		addTaskError(&resp.Diagnostics, "Unable to complete task", timeoutDelete, timeout, err)
+		return
+	}
+}
+
+func (r *WorkloadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *WorkloadResource) readWorkload(ctx context.Context, workloadID string, data *WorkloadResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	workloadResp, err := r.client.WorkloadReadByID(ctx, workloadID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read workload, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(workloadAPIToTFResource(ctx, workloadResp.JSON200.Workloads[0], data)...)
+
+	return diags
+}
+
+// workloadAPIToTFResource copies the workload into the resource model. Parameters which have a default in the
+// catalogue template are returned by the API, so only the parameters already in the model are refreshed unless
+// the model has no parameters, for example after an import.
+func workloadAPIToTFResource(ctx context.Context, workload models.Workload, data *WorkloadResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringPointerValue(workload.Id)
+	data.Name = types.StringValue(workload.Name)
+	data.AccountID = types.StringValue(workload.AccountId)
+	data.CatalogueID = types.StringValue(workload.CatalogueId)
+	data.CatalogueVersionID = types.StringPointerValue(workload.CatalogueVersionId)
+	data.Region = types.StringValue(workload.Region)
+	data.Status = types.StringPointerValue((*string)(workload.Status))
+
+	// tags removed outside of Terraform are refreshed so they show as drift, an empty map in the model is kept as is
+	switch {
+	case workload.Tags != nil && len(*workload.Tags) > 0:
+		data.Tags = types.MapValueMust(types.StringType, staxTagsToMapString((*models.StaxTags)(workload.Tags)))
+	case data.Tags.IsNull() || len(data.Tags.Elements()) > 0:
+		data.Tags = types.MapNull(types.StringType)
+	}
+
+	parameters, err := workloadParametersAPIToTF(workload.Parameters)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read workload parameters, got error: %s", err))
+		return diags
+	}
+
+	if !data.Parameters.IsNull() && !data.Parameters.IsUnknown() {
+		configured := data.Parameters.Elements()
+		for key := range parameters {
+			if _, ok := configured[key]; !ok {
+				delete(parameters, key)
+			}
+		}
+	}
+
+	if len(parameters) > 0 {
+		var d diag.Diagnostics
+		data.Parameters, d = types.MapValue(types.StringType, parameters)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+func workloadParametersTFToAPI(ctx context.Context, parameters types.Map) (*[]models.KeyValueRequestParameter, diag.Diagnostics) {
+	values := make(map[string]string)
+
+	diags := parameters.ElementsAs(ctx, &values, false)
+	if diags.HasError() || len(values) == 0 {
+		return nil, diags
+	}
+
+	keyValues := make([]models.KeyValueRequestParameter, 0, len(values))
+	for key, value := range values {
+		keyValues = append(keyValues, models.KeyValueRequestParameter{Key: key, Value: value})
+	}
+
+	return &keyValues, diags
+}
+
+// workloadParametersAPIToTF converts the workload parameters to strings, values which aren't strings are JSON encoded.
+func workloadParametersAPIToTF(parameters *models.Parameter) (map[string]attr.Value, error) {
+	values := make(map[string]attr.Value)
+
+	if parameters == nil {
+		return values, nil
+	}
+
+	for key, value := range *parameters {
+		if s, ok := value.(string); ok {
+			values[key] = types.StringValue(s)
+			continue
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		values[key] = types.StringValue(string(b))
+	}
+
+	return values, nil
+}
+
+// useStateForUnknownUnlessChanged returns a plan modifier which, like stringplanmodifier.UseStateForUnknown, keeps the
+// value in the state for an unknown planned value, unless the string attribute at dependsOn is changed. The catalogue
+// version of a workload belongs to the catalogue item, so it is unknown again once catalogue_id changes.
+func useStateForUnknownUnlessChanged(dependsOn path.Path) planmodifier.String {
+	return useStateForUnknownUnlessChangedModifier{dependsOn: dependsOn}
+}
+
+type useStateForUnknownUnlessChangedModifier struct {
+	dependsOn path.Path
+}
+
+func (m useStateForUnknownUnlessChangedModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Once set, the value of this attribute in state will not change unless %s changes.", m.dependsOn)
+}
+
+func (m useStateForUnknownUnlessChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownUnlessChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// nothing to keep when the resource is being created, or the value is configured
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var planned, prior types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.dependsOn, &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, m.dependsOn, &prior)...)
+
+	if resp.Diagnostics.HasError() || !planned.Equal(prior) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestWorkloadResource(t *testing.T) {
+
+	workloadID := "0d3c4f64-5b2e-4e57-9a8f-3bd2f2f0d1a2"
+	accountID := "f646e0cf-840c-401a-933c-1ef3432b5a37"
+	catalogueID := "3a4e5a8b-0f8f-4a55-9c52-9d0f2a6e6f11"
+	catalogueVersionID := "5a0c9f6e-3bde-4d6b-8f2b-2a1c7f0b8e44"
+	taskID := "fd4d3cbc-1ba0-4d21-be4b-b63ffe3af4f1"
+
+	si := mocks.NewServerInterface(t)
+
+	message := models.MessageEventDetail{}
+	if err := message.FromMessageEventDetail0(models.MessageEventDetail0{TaskId: aws.String(taskID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	si.On("WorkloadsCreateWorkload", mock.AnythingOfType("*echo.context")).Return(func(c echo.Context) error {
+		cw := new(models.WorkloadsCreateWorkload)
+		if err := c.Bind(cw); err != nil {
+			t.Fatalf("failed to bind request: %s", err)
+		}
+
+		if cw.Parameters == nil || len(*cw.Parameters) != 1 {
+			t.Errorf("expected one parameter, got: %v", cw.Parameters)
+		}
+
+		return c.JSON(200, &models.CreateWorkloadEvent{
+			Detail:     &models.CreateWorkloadDetail{Message: &message, OperationStatus: "STARTED"},
+			WorkloadId: aws.String(workloadID),
+		})
+	})
+
+	si.On("TasksReadTask", mock.AnythingOfType("*echo.context"), mock.AnythingOfType("string")).Return(func(c echo.Context, taskId string) error {
+		return c.JSON(200, &models.TasksReadTask{Status: staxsdk.TaskSucceeded, Workloads: &[]string{workloadID}})
+	})
+
+	si.On("WorkloadsReadWorkload", mock.AnythingOfType("*echo.context"), workloadID, mock.AnythingOfType("models.WorkloadsReadWorkloadParams")).Return(func(c echo.Context, workloadID string, params models.WorkloadsReadWorkloadParams) error {
+		status := models.WorkloadStatusACTIVE
+
+		return c.JSON(200, &models.WorkloadsReadWorkloadsResponse{
+			Workloads: []models.Workload{
+				{
+					Id:                 aws.String(workloadID),
+					Name:               "bucket-workload",
+					AccountId:          accountID,
+					CatalogueId:        catalogueID,
+					CatalogueVersionId: aws.String(catalogueVersionID),
+					Region:             "ap-southeast-2",
+					Status:             &status,
+					Parameters: &models.Parameter{
+						"BucketName":   "my-bucket",
+						"Versioning":   "Enabled",
+						"RetentionDay": 30,
+					},
+				},
+			},
+		})
+	})
+
+	si.On("WorkloadsDeleteWorkload", mock.AnythingOfType("*echo.context"), workloadID).Return(func(c echo.Context, workloadID string) error {
+		return c.JSON(200, map[string]interface{}{
+			"DetailType": "stax.workload.delete",
+			"Detail":     &models.BaseEventDetail{Message: &message, OperationStatus: "STARTED"},
+		})
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckStaxWorkloadConfig("bucket", accountID, catalogueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stax_workload.bucket", "id", workloadID),
+					resource.TestCheckResourceAttr("stax_workload.bucket", "catalogue_version_id", catalogueVersionID),
+					resource.TestCheckResourceAttr("stax_workload.bucket", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("stax_workload.bucket", "parameters.%", "1"),
+					resource.TestCheckResourceAttr("stax_workload.bucket", "parameters.BucketName", "my-bucket"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckStaxWorkloadConfig(label, accountID, catalogueID string) string {
+	configTemplate := `
+resource "stax_workload" "${label}" {
+	name         = "bucket-workload"
+	account_id   = "${account_id}"
+	catalogue_id = "${catalogue_id}"
+	region       = "ap-southeast-2"
+	parameters = {
+		BucketName = "my-bucket"
+	}
+}`
+	return fasttemplate.ExecuteString(configTemplate, "${", "}",
+		map[string]any{
+			"label":        label,
+			"account_id":   accountID,
+			"catalogue_id": catalogueID,
+		},
+	)
+}
+
+func TestWorkloadAPIToTFResource_Tags(t *testing.T) {
+	workload := models.Workload{Id: aws.String("0d3c4f64-5b2e-4e57-9a8f-3bd2f2f0d1a2"), Name: "data-lake"}
+
+	t.Run("clears tags removed outside of terraform", func(t *testing.T) {
+		data := &WorkloadResourceModel{
+			Tags: types.MapValueMust(types.StringType, map[string]attr.Value{"CostCode": types.StringValue("12345")}),
+		}
+
+		diags := workloadAPIToTFResource(context.Background(), workload, data)
+		require.False(t, diags.HasError(), diags)
+		require.True(t, data.Tags.IsNull())
+	})
+
+	t.Run("keeps an empty map of tags", func(t *testing.T) {
+		data := &WorkloadResourceModel{
+			Tags: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		}
+
+		diags := workloadAPIToTFResource(context.Background(), workload, data)
+		require.False(t, diags.HasError(), diags)
+		require.False(t, data.Tags.IsNull())
+		require.Empty(t, data.Tags.Elements())
+	})
+}
+
+func TestUseStateForUnknownUnlessChanged(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"catalogue_id":         schema.StringAttribute{Required: true},
+			"catalogue_version_id": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+
+	objectType := testSchema.Type().TerraformType(ctx)
+
+	value := func(catalogueID string, catalogueVersionID tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"catalogue_id":         tftypes.NewValue(tftypes.String, catalogueID),
+			"catalogue_version_id": catalogueVersionID,
+		})
+	}
+
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	modifyPlan := func(plannedCatalogueID string) types.String {
+		req := planmodifier.StringRequest{
+			Path:        path.Root("catalogue_version_id"),
+			ConfigValue: types.StringNull(),
+			PlanValue:   types.StringUnknown(),
+			StateValue:  types.StringValue("version-1"),
+			Config:      tfsdk.Config{Schema: testSchema, Raw: value(plannedCatalogueID, null)},
+			Plan:        tfsdk.Plan{Schema: testSchema, Raw: value(plannedCatalogueID, unknown)},
+			State:       tfsdk.State{Schema: testSchema, Raw: value("catalogue-1", tftypes.NewValue(tftypes.String, "version-1"))},
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+		useStateForUnknownUnlessChanged(path.Root("catalogue_id")).PlanModifyString(ctx, req, resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		return resp.PlanValue
+	}
+
+	t.Run("keeps the catalogue version when the catalogue is unchanged", func(t *testing.T) {
+		require.Equal(t, types.StringValue("version-1"), modifyPlan("catalogue-1"))
+	})
+
+	t.Run("leaves the catalogue version unknown when the catalogue changes", func(t *testing.T) {
+		require.True(t, modifyPlan("catalogue-2").IsUnknown())
+	})
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/helpers"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+)
+
+var _ datasource.DataSource = &WorkloadsDataSource{}
+
+func NewWorkloadsDataSource() datasource.DataSource {
+	return &WorkloadsDataSource{}
+}
+
+// WorkloadsDataSource defines the data source implementation.
+type WorkloadsDataSource struct {
+	client staxsdk.ClientInterface
+}
+
+type WorkloadDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	AccountID          types.String `tfsdk:"account_id"`
+	CatalogueID        types.String `tfsdk:"catalogue_id"`
+	CatalogueVersionID types.String `tfsdk:"catalogue_version_id"`
+	Region             types.String `tfsdk:"region"`
+	Status             types.String `tfsdk:"status"`
+	Parameters         types.Map    `tfsdk:"parameters"`
+	Outputs            types.Map    `tfsdk:"outputs"`
+	Tags               types.Map    `tfsdk:"tags"`
+}
+
+// WorkloadsDataSourceModel describes the data source data model.
+type WorkloadsDataSourceModel struct {
+	Filters   *WorkloadFiltersModel     `tfsdk:"filters"`
+	Workloads []WorkloadDataSourceModel `tfsdk:"workloads"`
+}
+
+type WorkloadFiltersModel struct {
+	AccountIDs   types.List   `tfsdk:"account_ids"`
+	CatalogueIDs types.List   `tfsdk:"catalogue_ids"`
+	Region       types.String `tfsdk:"region"`
+	Statuses     types.List   `tfsdk:"statuses"`
+}
+
+func (d *WorkloadsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workloads"
+}
+
+func (d *WorkloadsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workloads datasource",
+
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"account_ids": schema.ListAttribute{
+						MarkdownDescription: "A list of stax account identifiers used to filter workloads",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"catalogue_ids": schema.ListAttribute{
+						MarkdownDescription: "A list of workload catalogue item identifiers used to filter workloads",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "An AWS region used to filter workloads",
+						Optional:            true,
+					},
+					"statuses": schema.ListAttribute{
+						MarkdownDescription: "A list of statuses used to filter workloads, for example `ACTIVE` or `UPDATE_COMPLETE`",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"workloads": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the workload",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the workload",
+							Computed:            true,
+						},
+						"account_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the stax account the workload is deployed to",
+							Computed:            true,
+						},
+						"catalogue_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the workload catalogue item the workload was deployed from",
+							Computed:            true,
+						},
+						"catalogue_version_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the workload catalogue version the workload was deployed from",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The AWS region the workload is deployed to",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the workload",
+							Computed:            true,
+						},
+						"parameters": schema.MapAttribute{
+							MarkdownDescription: "The parameters the workload was deployed with, values which aren't strings are JSON encoded",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"outputs": schema.MapAttribute{
+							MarkdownDescription: "The outputs of the workload, values which aren't strings are JSON encoded",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"tags": schema.MapAttribute{
+							MarkdownDescription: "The tags associated with the workload",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkloadsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*staxsdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkloadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkloadsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &models.WorkloadsReadWorkloadsParams{}
+
+	var region string
+
+	if data.Filters != nil {
+		accountIDs := new([]string)
+		catalogueIDs := new([]string)
+		statuses := new([]string)
+
+		resp.Diagnostics.Append(data.Filters.AccountIDs.ElementsAs(ctx, accountIDs, false)...)
+		resp.Diagnostics.Append(data.Filters.CatalogueIDs.ElementsAs(ctx, catalogueIDs, false)...)
+		resp.Diagnostics.Append(data.Filters.Statuses.ElementsAs(ctx, statuses, false)...)
+
+		params.AccountIds = helpers.CommaDelimitedOptionalValue(*accountIDs)
+		params.CatalogueIds = helpers.CommaDelimitedOptionalValue(*catalogueIDs)
+		params.Filter = helpers.CommaDelimitedOptionalValue(*statuses)
+
+		region = data.Filters.Region.ValueString()
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workloadsResp, err := d.client.WorkloadRead(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workloads, got error: %s", err))
+		return
+	}
+
+	if workloadsResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to read workloads, got an empty response")
+		return
+	}
+
+	outputs, err := staxsdk.WorkloadOutputs(workloadsResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workload outputs, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "reading workloads", map[string]interface{}{
+		"count": len(workloadsResp.JSON200.Workloads),
+	})
+
+	for _, workload := range workloadsResp.JSON200.Workloads {
+		// the API doesn't support filtering on region, so this is applied to the results
+		if region != "" && workload.Region != region {
+			continue
+		}
+
+		workloadModel := WorkloadDataSourceModel{
+			ID:                 types.StringPointerValue(workload.Id),
+			Name:               types.StringValue(workload.Name),
+			AccountID:          types.StringValue(workload.AccountId),
+			CatalogueID:        types.StringValue(workload.CatalogueId),
+			CatalogueVersionID: types.StringPointerValue(workload.CatalogueVersionId),
+			Region:             types.StringValue(workload.Region),
+			Status:             types.StringPointerValue((*string)(workload.Status)),
+		}
+
+		parameters, err := workloadParametersAPIToTF(workload.Parameters)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workload parameters, got error: %s", err))
+			return
+		}
+
+		workloadModel.Parameters = types.MapValueMust(types.StringType, parameters)
+
+		mapVal, diag := types.MapValueFrom(ctx, types.StringType, outputs[aws.ToString(workload.Id)])
+		resp.Diagnostics.Append(diag...)
+
+		workloadModel.Outputs = mapVal
+
+		mapVal, diag = types.MapValueFrom(ctx, types.StringType, staxTagsToMap((*models.StaxTags)(workload.Tags)))
+		resp.Diagnostics.Append(diag...)
+
+		workloadModel.Tags = mapVal
+
+		data.Workloads = append(data.Workloads, workloadModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read workloads from data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labstack/echo/v4"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/mocks"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/models"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/openapi/core/server"
+	"github.com/stax-labs/terraform-provider-stax/internal/api/staxsdk"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorkloadsDataSource(t *testing.T) {
+
+	workloadID := "5b2b1a0e-7c1d-4f8e-9a4b-2c6d8e0f1a3b"
+	accountID := "9a0f2d4b-3c8e-4b71-8f5a-6e2d1c0b9a87"
+	catalogueID := "8d2ed5d5-1b5b-4c47-a9e2-b6bc4bd0b08f"
+
+	si := mocks.NewServerInterface(t)
+
+	readWorkloadsParams := models.WorkloadsReadWorkloadsParams{
+		AccountIds: aws.String(accountID),
+		Offset:     aws.Int(0),
+		Limit:      aws.Int(staxsdk.DefaultPageSize),
+	}
+
+	si.On("WorkloadsReadWorkloads",
+		mock.AnythingOfType("*echo.context"),
+		readWorkloadsParams,
+	).Return(func(c echo.Context, params models.WorkloadsReadWorkloadsParams) error {
+		return c.JSONBlob(200, []byte(fmt.Sprintf(`{
+			"Workloads": [
+				{
+					"Id": "%s",
+					"Name": "s3-bucket",
+					"AccountId": "%s",
+					"CatalogueId": "%s",
+					"CatalogueVersionId": null,
+					"Region": "ap-southeast-2",
+					"Status": "ACTIVE",
+					"Parameters": {"BucketName": "example-bucket"},
+					"Outputs": {"BucketArn": "arn:aws:s3:::example-bucket"},
+					"Tags": {"owner": "platform"}
+				}
+			]
+		}`, workloadID, accountID, catalogueID)))
+	})
+
+	e := echo.New()
+
+	server.RegisterHandlers(e, si)
+
+	ts := httptest.NewServer(e.Server.Handler)
+	defer ts.Close()
+
+	t.Setenv("INTEGRATION_TEST_ENDPOINT_URL", ts.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories:  testAccProtoV6ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`data "stax_workloads" "buckets" {
+  filters = {
+    account_ids = ["%s"]
+    region      = "ap-southeast-2"
+  }
+}`, accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.stax_workloads.buckets", "workloads.#", "1"),
+					resource.TestCheckResourceAttr("data.stax_workloads.buckets", "workloads.0.id", workloadID),
+					resource.TestCheckResourceAttr("data.stax_workloads.buckets", "workloads.0.parameters.BucketName", "example-bucket"),
+					resource.TestCheckResourceAttr("data.stax_workloads.buckets", "workloads.0.outputs.BucketArn", "arn:aws:s3:::example-bucket"),
+					resource.TestCheckResourceAttr("data.stax_workloads.buckets", "workloads.0.tags.owner", "platform"),
+				),
+			},
+		},
+	})
+}